@@ -0,0 +1,151 @@
+package map_validator
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// coerceString converts a raw string coming from a form, query string, path
+// or header into the kind declared by rule (or by its enum items). A value
+// that does not parse is returned unchanged, so validation reports it as a
+// regular type mismatch for the field; a number that does not fit the kind
+// is kept as a json.Number, so it gets the same range error as in a JSON
+// body.
+func coerceString(raw string, rule Rules) interface{} {
+	kind := rule.Type
+	if rule.Enum != nil {
+		if enumType := reflect.TypeOf(rule.Enum.Items); enumType != nil && enumType.Kind() == reflect.Slice {
+			kind = enumType.Elem().Kind()
+		}
+	}
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		num, err := strconv.ParseInt(strings.TrimSpace(raw), 10, kindBits(kind))
+		if err != nil {
+			return outOfRange(raw, errors.Is(err, strconv.ErrRange))
+		}
+		return reflect.ValueOf(num).Convert(kindType(kind)).Interface()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		num, err := strconv.ParseUint(strings.TrimSpace(raw), 10, kindBits(kind))
+		if err != nil {
+			_, negErr := strconv.ParseInt(strings.TrimSpace(raw), 10, 64)
+			signed := negErr == nil || errors.Is(negErr, strconv.ErrRange)
+			return outOfRange(raw, errors.Is(err, strconv.ErrRange) || signed)
+		}
+		return reflect.ValueOf(num).Convert(kindType(kind)).Interface()
+	case reflect.Float32, reflect.Float64:
+		num, err := strconv.ParseFloat(strings.TrimSpace(raw), kindBits(kind))
+		if err != nil {
+			return outOfRange(raw, errors.Is(err, strconv.ErrRange))
+		}
+		if kind == reflect.Float32 {
+			return float32(num)
+		}
+		return num
+	case reflect.Bool:
+		if b, ok := parseFormBool(raw); ok {
+			return b
+		}
+		return raw
+	}
+	if rule.UUID || rule.UUIDToString {
+		return strings.TrimSpace(raw)
+	}
+	return raw
+}
+
+// outOfRange returns raw as a json.Number when it is a number outside the
+// range of the rule's kind, and unchanged otherwise.
+func outOfRange(raw string, overflow bool) interface{} {
+	if overflow {
+		return json.Number(strings.TrimSpace(raw))
+	}
+	return raw
+}
+
+// coerceValues turns every value sent for a key (query string, header)
+// into the data validated against rule. List rules get every value as a
+// list element; other rules use the first value. Missing or empty scalar
+// values become nil so Nullable / Default apply.
+func coerceValues(values []string, rule Rules) interface{} {
+	if len(values) == 0 {
+		return nil
+	}
+	if rule.List != nil {
+		res := make([]interface{}, 0, len(values))
+		for _, value := range values {
+			res = append(res, coerceString(value, rule))
+		}
+		return res
+	}
+	if values[0] == "" {
+		return nil
+	}
+	return coerceString(values[0], rule)
+}
+
+// isCoercibleRule reports whether a string source (form, query, path,
+// header) can produce a value for rule.
+func isCoercibleRule(rule Rules) bool {
+	if rule.Object != nil || rule.ListObject != nil || rule.AnonymousObject {
+		return false
+	}
+	switch rule.Type {
+	case reflect.Invalid, reflect.String, reflect.Bool:
+		return true
+	}
+	return isIntegerFamily(rule.Type)
+}
+
+func parseFormBool(raw string) (bool, bool) {
+	switch strings.ToLower(strings.TrimSpace(raw)) {
+	case "true", "1", "on":
+		return true, true
+	case "false", "0", "off":
+		return false, true
+	}
+	return false, false
+}
+
+func kindBits(kind reflect.Kind) int {
+	switch kind {
+	case reflect.Int8, reflect.Uint8:
+		return 8
+	case reflect.Int16, reflect.Uint16:
+		return 16
+	case reflect.Int32, reflect.Uint32, reflect.Float32:
+		return 32
+	case reflect.Int, reflect.Uint:
+		return strconv.IntSize
+	}
+	return 64
+}
+
+func kindType(kind reflect.Kind) reflect.Type {
+	switch kind {
+	case reflect.Int:
+		return reflect.TypeOf(int(0))
+	case reflect.Int8:
+		return reflect.TypeOf(int8(0))
+	case reflect.Int16:
+		return reflect.TypeOf(int16(0))
+	case reflect.Int32:
+		return reflect.TypeOf(int32(0))
+	case reflect.Int64:
+		return reflect.TypeOf(int64(0))
+	case reflect.Uint:
+		return reflect.TypeOf(uint(0))
+	case reflect.Uint8:
+		return reflect.TypeOf(uint8(0))
+	case reflect.Uint16:
+		return reflect.TypeOf(uint16(0))
+	case reflect.Uint32:
+		return reflect.TypeOf(uint32(0))
+	case reflect.Uint64:
+		return reflect.TypeOf(uint64(0))
+	}
+	return nil
+}
@@ -0,0 +1,147 @@
+package map_validator
+
+import (
+	"fmt"
+	"strings"
+)
+
+// AllOf passes when the value passes every rule. All branches are checked,
+// so the error lists each one that failed.
+//
+//	AllOf(Str().WithMax(64), Email(), Str().Regex(`@example\.com$`))
+func AllOf(rules ...Rules) Rules { return Rules{AllOf: rules} }
+
+// AnyOf passes when the value passes at least one rule, e.g. an IPv4 address
+// or a host name:
+//
+//	AnyOf(IPv4(), Str().Regex(`^[a-z0-9.-]+$`))
+func AnyOf(rules ...Rules) Rules { return Rules{AnyOf: rules} }
+
+// OneOf passes when the value passes exactly one rule.
+func OneOf(rules ...Rules) Rules { return Rules{OneOf: rules} }
+
+// Not passes when the value fails rule.
+//
+//	AllOf(Str(), Not(StrEnum("root", "admin")))
+func Not(rule Rules) Rules { return Rules{Not: &rule} }
+
+func (r Rules) hasCombinator() bool {
+	return len(r.AllOf) > 0 || len(r.AnyOf) > 0 || len(r.OneOf) > 0 || r.Not != nil
+}
+
+// validateCombinators checks the AllOf / AnyOf / OneOf / Not branches of
+// validator. Every branch gets the value as it was sent; the result is the
+// one of the first passing branch, so e.g. UseNumber values are converted
+// by the branch that accepted them.
+func validateCombinators(data interface{}, validator Rules, dataFrom loadFromType, field string) (interface{}, error) {
+	result := data
+	if len(validator.AllOf) > 0 {
+		branches := make([]error, len(validator.AllOf))
+		failed := false
+		for i, branch := range validator.AllOf {
+			res, err := validateValueInternal(data, branch, dataFrom, field)
+			err = firstError(err)
+			if err != nil {
+				branches[i] = err
+				failed = true
+			} else if i == 0 {
+				result = res
+			}
+		}
+		if failed {
+			return nil, combinatorError(CodeAllOf, CodeAllOf, validator.CustomMsg.OnAllOf, field, branches, nil)
+		}
+	}
+	if len(validator.AnyOf) > 0 {
+		branches := make([]error, len(validator.AnyOf))
+		matched := false
+		for i, branch := range validator.AnyOf {
+			res, err := validateValueInternal(data, branch, dataFrom, field)
+			err = firstError(err)
+			if err == nil {
+				result = res
+				matched = true
+				break
+			}
+			branches[i] = err
+		}
+		if !matched {
+			return nil, combinatorError(CodeAnyOf, CodeAnyOf, validator.CustomMsg.OnAnyOf, field, branches, nil)
+		}
+	}
+	if len(validator.OneOf) > 0 {
+		branches := make([]error, len(validator.OneOf))
+		var matched []int
+		for i, branch := range validator.OneOf {
+			res, err := validateValueInternal(data, branch, dataFrom, field)
+			err = firstError(err)
+			if err != nil {
+				branches[i] = err
+				continue
+			}
+			if len(matched) == 0 {
+				result = res
+			}
+			matched = append(matched, i)
+		}
+		switch {
+		case len(matched) == 0:
+			return nil, combinatorError(CodeOneOf, CodeOneOf, validator.CustomMsg.OnOneOf, field, branches, nil)
+		case len(matched) > 1:
+			return nil, combinatorError(CodeOneOf, MsgOneOfMany, validator.CustomMsg.OnOneOf, field, nil, matched)
+		}
+	}
+	if validator.Not != nil {
+		if _, err := validateValueInternal(data, *validator.Not, dataFrom, field); err == nil {
+			return nil, combinatorError(CodeNot, CodeNot, validator.CustomMsg.OnNot, field, nil, nil)
+		}
+	}
+	return result, nil
+}
+
+// combinatorError builds the error of a combinator rule. branches holds the
+// branch failures by rule index, matched the branches that passed a OneOf
+// that allows only one.
+func combinatorError(code, key string, custom *string, field string, branches []error, matched []int) *FieldError {
+	meta := MessageMeta{Field: &field}
+	if matched != nil {
+		matchedBranches := fmt.Sprintf("%v", matched)
+		meta.MatchedBranches = &matchedBranches
+	}
+	fe := newFieldError(code, meta)
+	if custom != nil {
+		fe.template = *custom
+	} else {
+		fe.key = key
+		fe.prefixed = field != "value"
+	}
+	if matched != nil {
+		fe.Actual = matched
+	}
+	fe.Branches = branches
+	fe.render()
+	return fe
+}
+
+// branchMessages renders the failed branches as "[i] message", without the
+// field prefix the combinator message already has.
+func branchMessages(branches []error, locale string) string {
+	var parts []string
+	for i, err := range branches {
+		if err == nil {
+			continue
+		}
+		msg := err.Error()
+		if fe, ok := err.(*FieldError); ok {
+			plain := *fe
+			plain.locale = locale
+			if plain.template == "" {
+				plain.prefixed = false
+			}
+			plain.render()
+			msg = plain.Message
+		}
+		parts = append(parts, fmt.Sprintf("[%d] %s", i, msg))
+	}
+	return strings.Join(parts, "; ")
+}
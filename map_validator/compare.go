@@ -0,0 +1,160 @@
+package map_validator
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// CompareOp is the operator of a FieldCompare.
+type CompareOp string
+
+const (
+	CompareEq  CompareOp = "eq"
+	CompareNe  CompareOp = "ne"
+	CompareGt  CompareOp = "gt"
+	CompareGte CompareOp = "gte"
+	CompareLt  CompareOp = "lt"
+	CompareLte CompareOp = "lte"
+)
+
+// FieldCompare compares the value of a rule with the sibling field Field,
+// e.g. {Op: CompareGt, Field: "start_date"} on "end_date". Like Unique it
+// runs on the validated values after manipulators; it is skipped when
+// either value is null.
+//
+// Numbers are compared numerically, time.Time values and date strings
+// (RFC 3339, "2006-01-02" or "2006-01-02 15:04:05") by time and other
+// strings lexically. Eq and Ne compare two strings exactly, so a password
+// confirmation never matches an equivalent date, and also accept values
+// that cannot be ordered.
+type FieldCompare struct {
+	Op    CompareOp
+	Field string
+}
+
+// code is the rule code reported when the comparison fails
+func (op CompareOp) code() string {
+	switch op {
+	case CompareEq:
+		return CodeEqField
+	case CompareNe:
+		return CodeNeField
+	case CompareGt:
+		return CodeGtField
+	case CompareGte:
+		return CodeGteField
+	case CompareLt:
+		return CodeLtField
+	}
+	return CodeLteField
+}
+
+// holds reports whether value op target is true
+func (op CompareOp) holds(value, target interface{}) bool {
+	if a, isString := value.(string); isString && (op == CompareEq || op == CompareNe) {
+		if b, isString := target.(string); isString {
+			return (a == b) == (op == CompareEq)
+		}
+	}
+	c, ok := compareFieldValues(value, target)
+	switch op {
+	case CompareEq:
+		return (ok && c == 0) || (!ok && reflect.DeepEqual(value, target))
+	case CompareNe:
+		return !CompareEq.holds(value, target)
+	case CompareGt:
+		return ok && c > 0
+	case CompareGte:
+		return ok && c >= 0
+	case CompareLt:
+		return ok && c < 0
+	case CompareLte:
+		return ok && c <= 0
+	}
+	return false
+}
+
+var compareDateLayouts = []string{time.RFC3339, "2006-01-02 15:04:05", "2006-01-02"}
+
+func parseCompareDate(s string) (time.Time, bool) {
+	for _, layout := range compareDateLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// compareFieldValues orders a against b; ok is false when the two values
+// cannot be ordered (different kinds, bools, objects, ...).
+func compareFieldValues(a, b interface{}) (int, bool) {
+	if fa, exactA, ok := numberValue(a); ok {
+		fb, exactB, ok := numberValue(b)
+		if !ok {
+			return 0, false
+		}
+		ua, aIsUint := exactA.(uint64)
+		ub, bIsUint := exactB.(uint64)
+		if aIsUint && bIsUint {
+			switch {
+			case ua < ub:
+				return -1, true
+			case ua > ub:
+				return 1, true
+			}
+			return 0, true
+		}
+		if bound, isInt := exactB.(int64); isInt && exactA != nil {
+			return compareInt(fa, exactA, bound), true
+		}
+		if bound, isInt := exactA.(int64); isInt && exactB != nil {
+			return -compareInt(fb, exactB, bound), true
+		}
+		return compareOrdered(fa, fb), true
+	}
+	ta, aIsTime := a.(time.Time)
+	tb, bIsTime := b.(time.Time)
+	sa, aIsString := a.(string)
+	sb, bIsString := b.(string)
+	if aIsString && bIsTime {
+		ta, aIsTime = parseCompareDate(sa)
+	}
+	if bIsString && aIsTime {
+		tb, bIsTime = parseCompareDate(sb)
+	}
+	if aIsString && bIsString {
+		ta, aIsTime = parseCompareDate(sa)
+		tb, bIsTime = parseCompareDate(sb)
+		if !aIsTime || !bIsTime {
+			return strings.Compare(sa, sb), true
+		}
+	}
+	if aIsTime && bIsTime {
+		return ta.Compare(tb), true
+	}
+	return 0, false
+}
+
+// compareError reports that value of field fails cmp against target
+func compareError(custom *CustomMsg, field string, cmp FieldCompare, value, target interface{}) *FieldError {
+	compareField := cmp.Field
+	actual := fmt.Sprintf("%v", value)
+	compareValue := fmt.Sprintf("%v", target)
+	meta := MessageMeta{
+		CompareField: &compareField,
+		CompareValue: &compareValue,
+		ActualValue:  &actual,
+	}
+	var fe *FieldError
+	if custom != nil && custom.OnCompareField != nil {
+		meta.Field = &field
+		fe = buildMessage(cmp.Op.code(), *custom.OnCompareField, meta)
+	} else {
+		fe = buildErrorMessageKey(field, cmp.Op.code(), cmp.Op.code(), meta)
+	}
+	fe.Expected = target
+	fe.Actual = value
+	return fe
+}
@@ -0,0 +1,143 @@
+package map_validator
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// ConditionOp is the operator of a Condition.
+type ConditionOp string
+
+const (
+	ConditionEquals    ConditionOp = "eq"
+	ConditionNotEquals ConditionOp = "ne"
+	ConditionIn        ConditionOp = "in"
+	ConditionFunc      ConditionOp = "func"
+)
+
+// Condition tests the value of a sibling field for RequiredWhen and
+// ForbiddenWhen. It sees the value as sent (nil when the field is missing
+// or null); numbers are compared numerically, so FieldEquals("qty", 3)
+// matches a JSON 3, and other values exactly.
+type Condition struct {
+	Field string
+	Op    ConditionOp
+	// Values are the values compared by ConditionEquals, ConditionNotEquals
+	// (the field is none of them) and ConditionIn. Without Values these
+	// conditions never hold.
+	Values []interface{}
+	// Func is the predicate of ConditionFunc.
+	Func func(value interface{}) bool
+	// Description is shown as ${condition} for ConditionFunc, e.g.
+	// "total is over 100".
+	Description string
+}
+
+// FieldEquals holds when field is value.
+func FieldEquals(field string, value interface{}) Condition {
+	return Condition{Field: field, Op: ConditionEquals, Values: []interface{}{value}}
+}
+
+// FieldNotEquals holds when field is not value, including when it is
+// missing.
+func FieldNotEquals(field string, value interface{}) Condition {
+	return Condition{Field: field, Op: ConditionNotEquals, Values: []interface{}{value}}
+}
+
+// FieldIn holds when field is one of values.
+func FieldIn(field string, values ...interface{}) Condition {
+	return Condition{Field: field, Op: ConditionIn, Values: values}
+}
+
+// FieldMatches holds when fn returns true for the value of field;
+// description names the condition in error messages.
+func FieldMatches(field, description string, fn func(value interface{}) bool) Condition {
+	return Condition{Field: field, Op: ConditionFunc, Func: fn, Description: description}
+}
+
+func (c Condition) holds(value interface{}) bool {
+	switch c.Op {
+	case ConditionEquals, ConditionIn:
+		return inConditionValues(c.Values, value)
+	case ConditionNotEquals:
+		return len(c.Values) > 0 && !inConditionValues(c.Values, value)
+	case ConditionFunc:
+		return c.Func != nil && c.Func(value)
+	}
+	return false
+}
+
+// inConditionValues reports whether value is one of values. Numbers are
+// compared numerically, anything else exactly.
+func inConditionValues(values []interface{}, value interface{}) bool {
+	_, _, isNumber := numberValue(value)
+	for _, item := range values {
+		if _, _, ok := numberValue(item); ok && isNumber {
+			if c, _ := compareFieldValues(value, item); c == 0 {
+				return true
+			}
+		} else if reflect.DeepEqual(value, item) {
+			return true
+		}
+	}
+	return false
+}
+
+// String renders the condition for ${condition}, e.g. `status == shipped`
+// or `account_type in [business, enterprise]`.
+func (c Condition) String() string {
+	switch c.Op {
+	case ConditionEquals:
+		return fmt.Sprintf("%s == %s", c.Field, conditionValues(c.Values))
+	case ConditionNotEquals:
+		return fmt.Sprintf("%s != %s", c.Field, conditionValues(c.Values))
+	case ConditionIn:
+		return fmt.Sprintf("%s in [%s]", c.Field, conditionValues(c.Values))
+	}
+	if c.Description != "" {
+		return c.Description
+	}
+	return fmt.Sprintf("%s matches %s", c.Field, c.Op)
+}
+
+func conditionValues(values []interface{}) string {
+	parts := make([]string, len(values))
+	for i, v := range values {
+		parts[i] = fmt.Sprintf("%v", v)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// matchingCondition returns the first of conditions that holds in data
+func matchingCondition(conditions []Condition, data map[string]interface{}) (Condition, bool) {
+	for _, c := range conditions {
+		if c.holds(data[c.Field]) {
+			return c, true
+		}
+	}
+	return Condition{}, false
+}
+
+// conditionError reports field as required (CodeRequiredWhen) or forbidden
+// (CodeForbiddenWhen) because c holds for the sibling value in data.
+func conditionError(code string, custom *string, field string, c Condition, data map[string]interface{}) *FieldError {
+	condition := c.String()
+	conditionField := c.Field
+	values := conditionValues(c.Values)
+	meta := MessageMeta{
+		Field:           &field,
+		Condition:       &condition,
+		ConditionField:  &conditionField,
+		ConditionValues: &values,
+	}
+	var fe *FieldError
+	if custom != nil {
+		fe = buildMessage(code, *custom, meta)
+	} else {
+		fe = buildCatalogMessage(code, code, meta)
+	}
+	fe.Expected = c.Values
+	fe.Actual = data[c.Field]
+	return fe
+}
@@ -0,0 +1,164 @@
+package map_validator
+
+import (
+	"math"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// EnumOf builds an enum rule from typed constants, e.g.
+//
+//	type Status string
+//	const (StatusActive Status = "active"; StatusDone Status = "done")
+//
+//	SetRule("status", EnumOf(StatusActive, StatusDone))
+//
+// Values are compared on their underlying kind, so JSON "active" matches
+// StatusActive.
+func EnumOf[T ~string | ~bool | ~int | ~int8 | ~int16 | ~int32 | ~int64 |
+	~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~float32 | ~float64](values ...T) Rules {
+	return Rules{Type: reflect.TypeOf(values).Elem().Kind(), Enum: &EnumField[any]{Items: values}}
+}
+
+// EnumInfo describes the enum of a rule, for documentation or schema export.
+type EnumInfo struct {
+	// Kind is the underlying kind of the items and Type their Go type,
+	// e.g. string and main.Status.
+	Kind reflect.Kind
+	Type reflect.Type
+	// Values are the items in declaration order, with their declared type.
+	Values          []interface{}
+	CaseInsensitive bool
+}
+
+// EnumInfo returns the enum of the rule (or of its List elements); ok is
+// false when the rule has no enum.
+func (r Rules) EnumInfo() (info EnumInfo, ok bool) {
+	if r.Enum == nil {
+		return info, false
+	}
+	items := reflect.ValueOf(r.Enum.Items)
+	if items.Kind() != reflect.Slice {
+		return info, false
+	}
+	info.Type = items.Type().Elem()
+	info.Kind = info.Type.Kind()
+	info.CaseInsensitive = r.Enum.CaseInsensitive
+	for i := 0; i < items.Len(); i++ {
+		info.Values = append(info.Values, items.Index(i).Interface())
+	}
+	return info, true
+}
+
+// Enums lists the enums declared in wrapper by field path: "status",
+// "address.country", "items[].kind" for ListOfObject items and "tags[]" for
+// List elements.
+func Enums(wrapper RulesWrapper) map[string]EnumInfo {
+	res := map[string]EnumInfo{}
+	collectEnums(wrapper, "", res, map[RulesWrapper]bool{})
+	return res
+}
+
+func collectEnums(wrapper RulesWrapper, prefix string, res map[string]EnumInfo, seen map[RulesWrapper]bool) {
+	if wrapper == nil || seen[wrapper] {
+		return
+	}
+	seen[wrapper] = true
+	defer delete(seen, wrapper)
+	rules := wrapper.getRules()
+	keys := make([]string, 0, len(rules))
+	for key := range rules {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		rule := rules[key]
+		path := prefix + key
+		if info, ok := rule.EnumInfo(); ok {
+			if rule.List != nil {
+				path += "[]"
+			}
+			res[path] = info
+		}
+		collectEnums(rule.Object, path+".", res, seen)
+		collectEnums(rule.ListObject, path+"[].", res, seen)
+	}
+}
+
+// matchStringEnum returns the item of a string enum that value matches,
+// following the CaseInsensitive / TrimSpace / Normalize options.
+func matchStringEnum(enum *EnumField[any], items []string, value string) (string, bool) {
+	normalize := func(s string) string {
+		if enum.Normalize != nil {
+			s = enum.Normalize(s)
+		}
+		if enum.TrimSpace {
+			s = strings.TrimSpace(s)
+		}
+		return s
+	}
+	value = normalize(value)
+	for _, item := range items {
+		normalized := normalize(item)
+		if normalized == value || (enum.CaseInsensitive && strings.EqualFold(normalized, value)) {
+			return item, true
+		}
+	}
+	return "", false
+}
+
+// enumItem looks data up in the enum items, comparing on the underlying
+// kind: numbers by value (JSON float64 against any int, uint or float kind),
+// strings with the EnumField options. item is the canonical value for
+// string enums (the item as a plain string) and data otherwise. supported
+// is false for item kinds that have no scalar comparison.
+func enumItem(enum *EnumField[any], data interface{}) (item interface{}, found, supported bool) {
+	items := reflect.ValueOf(enum.Items)
+	value := reflect.ValueOf(data)
+	f, exact, isNumber := numberValue(data)
+	match := func(i int) bool {
+		candidate := items.Index(i)
+		switch candidate.Kind() {
+		case reflect.Bool:
+			return value.Kind() == reflect.Bool && value.Bool() == candidate.Bool()
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return isNumber && compareInt(f, exact, candidate.Int()) == 0
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			u := candidate.Uint()
+			if u > math.MaxInt64 {
+				return exact == u
+			}
+			return isNumber && compareInt(f, exact, int64(u)) == 0
+		case reflect.Float32:
+			return isNumber && float32(f) == float32(candidate.Float())
+		case reflect.Float64:
+			return isNumber && f == candidate.Float()
+		}
+		return false
+	}
+
+	switch items.Type().Elem().Kind() {
+	case reflect.String:
+		if value.Kind() != reflect.String {
+			return nil, false, true
+		}
+		values := make([]string, items.Len())
+		for i := range values {
+			values[i] = items.Index(i).String()
+		}
+		matched, ok := matchStringEnum(enum, values, value.String())
+		return matched, ok, true
+	case reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		for i := 0; i < items.Len(); i++ {
+			if match(i) {
+				return data, true, true
+			}
+		}
+		return nil, false, true
+	}
+	return nil, false, false
+}
@@ -0,0 +1,213 @@
+package map_validator
+
+import "strings"
+
+// ValidationErrors is returned when a run collects every failure instead of
+// stopping at the first one (see RunValidateAll and Setting.AllErrors).
+//
+// It supports errors.Is / errors.As through Unwrap, so a caller can still
+// match a specific failure inside the aggregate.
+type ValidationErrors []error
+
+func (ve ValidationErrors) Error() string {
+	msgs := make([]string, 0, len(ve))
+	for _, err := range ve {
+		if err != nil {
+			msgs = append(msgs, err.Error())
+		}
+	}
+	return strings.Join(msgs, "; ")
+}
+
+func (ve ValidationErrors) Unwrap() []error {
+	return ve
+}
+
+// firstError returns the first failure of an aggregate, or err itself.
+func firstError(err error) error {
+	if ve, ok := err.(ValidationErrors); ok && len(ve) > 0 {
+		return ve[0]
+	}
+	return err
+}
+
+// Rule codes carried by FieldError.Code. They are stable identifiers that
+// callers can switch on instead of parsing the English message.
+const (
+	CodeRequired         = "required"
+	CodeType             = "type"
+	CodeRange            = "range"
+	CodeMin              = "min"
+	CodeMax              = "max"
+	CodeEnum             = "enum"
+	CodeUUID             = "uuid"
+	CodeEmail            = "email"
+	CodeIPv4             = "ipv4"
+	CodeIPv4Network      = "ipv4_network"
+	CodeIPv4Prefix       = "ipv4_optional_prefix"
+	CodeRegex            = "regex"
+	CodeObject           = "object"
+	CodeList             = "list"
+	CodeUnique           = "unique"
+	CodeStrictUnknownKey = "strict_unknown_key"
+	CodeRequiredIf       = "required_if"
+	CodeRequiredWithout  = "required_without"
+	CodeSourceConflict   = "source_conflict"
+	CodeFile             = "file"
+	CodeFileMinSize      = "file_min_size"
+	CodeFileMaxSize      = "file_max_size"
+	CodeFileType         = "file_type"
+	CodeFileExtension    = "file_extension"
+	CodeImage            = "image"
+	CodeImageFormat      = "image_format"
+	CodeImageDimension   = "image_dimension"
+	CodeImageAspectRatio = "image_aspect_ratio"
+	CodeNonZero          = "non_zero"
+	CodeMultipleOf       = "multiple_of"
+	CodeAllOf            = "all_of"
+	CodeAnyOf            = "any_of"
+	CodeOneOf            = "one_of"
+	CodeNot              = "not"
+	CodeEqField          = "eq_field"
+	CodeNeField          = "ne_field"
+	CodeGtField          = "gt_field"
+	CodeGteField         = "gte_field"
+	CodeLtField          = "lt_field"
+	CodeLteField         = "lte_field"
+	CodeRequiredWhen     = "required_when"
+	CodeForbiddenWhen    = "forbidden_when"
+)
+
+// FieldError is the error returned for a single failed rule. Error() returns
+// the rendered message (default or CustomMsg), so printing it gives the same
+// text as before; use errors.As to get at the structured details.
+type FieldError struct {
+	// Field is the rule key that failed, or the header name for rules
+	// loaded from a header (FromHeader).
+	Field string
+	// Path is the full dotted path of the value, e.g. address.city,
+	// items[3].sku or tags[2].
+	Path string
+	// Pointer is Path in JSON Pointer form, e.g. /items/3/sku.
+	Pointer string
+	// Code is one of the Code* constants.
+	Code string
+	// Expected is the constraint that was violated (min/max bound, expected
+	// type, enum list, ...) when the rule has one.
+	Expected interface{}
+	// Actual is the offending value, length or type when known.
+	Actual interface{}
+	// Params holds the message template variables (without the ${} wrapper)
+	// that were available when the error was built.
+	Params map[string]string
+	// Message is the rendered error message.
+	Message string
+	// Branches holds the failures of the branches of an AllOf, AnyOf or
+	// OneOf rule, by rule index; nil entries are branches that passed.
+	Branches []error
+
+	// rel is the path relative to the scope the error was built in. The
+	// message is rendered either from template (a CustomMsg) or from the
+	// catalog entry key of locale, and re-rendered when path or locale change.
+	rel      fieldPath
+	template string
+	key      string
+	prefixed bool
+	locale   string
+}
+
+func (fe *FieldError) Error() string {
+	return fe.Message
+}
+
+func newFieldError(code string, meta MessageMeta) *FieldError {
+	fe := &FieldError{
+		Code:   code,
+		Params: meta.variables(),
+	}
+	if meta.Field != nil {
+		fe.Field = *meta.Field
+		fe.rel = fieldPath{}.child(fe.Field)
+		fe.setPath(fe.rel)
+	}
+	switch {
+	case meta.ExpectedMinLength != nil:
+		fe.Expected = *meta.ExpectedMinLength
+	case meta.ExpectedMaxLength != nil:
+		fe.Expected = *meta.ExpectedMaxLength
+	case meta.ExpectedMinNumber != nil:
+		fe.Expected = *meta.ExpectedMinNumber
+	case meta.ExpectedMaxNumber != nil:
+		fe.Expected = *meta.ExpectedMaxNumber
+	case meta.MultipleOf != nil:
+		fe.Expected = *meta.MultipleOf
+	case meta.EnumValues != nil:
+		fe.Expected = *meta.EnumValues
+	case meta.AllowedTypes != nil:
+		fe.Expected = *meta.AllowedTypes
+	case meta.AllowedExtensions != nil:
+		fe.Expected = *meta.AllowedExtensions
+	case meta.DeniedExtensions != nil:
+		fe.Expected = *meta.DeniedExtensions
+	case meta.ExpectedType != nil:
+		fe.Expected = meta.ExpectedType.String()
+	}
+	switch {
+	case meta.ActualNumber != nil:
+		fe.Actual = meta.ActualNumber
+	case meta.ActualValue != nil:
+		fe.Actual = *meta.ActualValue
+	case meta.ActualLength != nil:
+		fe.Actual = *meta.ActualLength
+	case meta.ActualSize != nil:
+		fe.Actual = *meta.ActualSize
+	case meta.MimeType != nil:
+		fe.Actual = *meta.MimeType
+	case meta.Extension != nil:
+		fe.Actual = *meta.Extension
+	case meta.ActualType != nil:
+		fe.Actual = meta.ActualType.String()
+	}
+	return fe
+}
+
+func (fe *FieldError) setPath(path fieldPath) {
+	fe.Path = path.dotted
+	fe.Pointer = path.pointer
+	fe.Params["field_path"] = path.dotted
+	for _, branch := range fe.Branches {
+		if branchErr, ok := branch.(*FieldError); ok {
+			branchErr.setPath(path)
+		}
+	}
+	fe.render()
+}
+
+func (fe *FieldError) render() {
+	if fe.Branches != nil {
+		fe.Params["branch_errors"] = branchMessages(fe.Branches, fe.locale)
+	}
+	if fe.template != "" {
+		fe.Message = renderMessage(fe.template, fe.Params)
+		return
+	}
+	if fe.key == "" {
+		return
+	}
+	msg := lookupMessage(fe.locale, fe.key)
+	if fe.prefixed {
+		msg = lookupMessage(fe.locale, MsgFieldPrefix) + msg
+	}
+	fe.Message = renderMessage(msg, fe.Params)
+}
+
+// FieldErrors returns every FieldError held by the aggregate.
+func (ve ValidationErrors) FieldErrors() []*FieldError {
+	var res []*FieldError
+	for _, err := range ve {
+		if fe, ok := err.(*FieldError); ok {
+			res = append(res, fe)
+		}
+	}
+	return res
+}
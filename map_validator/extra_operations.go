@@ -52,7 +52,19 @@ func (state *ExtraOperationData) Bind(i interface{}) error {
 	//	}
 	//}
 
-	jsonStringData, err := json.Marshal(data)
+	// Uploaded files can't go through JSON; they are set directly on the
+	// target after the rest of the data is bound.
+	files := map[string]interface{}{}
+	plain := make(map[string]interface{}, len(data))
+	for key, value := range data {
+		if isFileValue(value) {
+			files[key] = value
+			continue
+		}
+		plain[key] = value
+	}
+
+	jsonStringData, err := json.Marshal(plain)
 	if err != nil {
 		return err
 	}
@@ -60,6 +72,9 @@ func (state *ExtraOperationData) Bind(i interface{}) error {
 	if err != nil {
 		return err
 	}
+	if len(files) > 0 {
+		return bindFiles(i, files)
+	}
 
 	return nil
 }
@@ -0,0 +1,57 @@
+package map_validator
+
+import (
+	"fmt"
+	"strings"
+)
+
+// fieldPath tracks where a value sits inside the payload, both in dotted form
+// (address.city, items[3].sku) and as a JSON Pointer (/address/city,
+// /items/3/sku).
+type fieldPath struct {
+	dotted  string
+	pointer string
+}
+
+var pointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+
+func (p fieldPath) child(key string) fieldPath {
+	dotted := key
+	if p.dotted != "" {
+		dotted = p.dotted + "." + key
+	}
+	return fieldPath{dotted: dotted, pointer: p.pointer + "/" + pointerEscaper.Replace(key)}
+}
+
+func (p fieldPath) index(i int) fieldPath {
+	return fieldPath{dotted: fmt.Sprintf("%s[%d]", p.dotted, i), pointer: fmt.Sprintf("%s/%d", p.pointer, i)}
+}
+
+// join appends a path that is relative to p.
+func (p fieldPath) join(rel fieldPath) fieldPath {
+	if p.dotted == "" {
+		return rel
+	}
+	if rel.dotted == "" {
+		return p
+	}
+	return fieldPath{dotted: p.dotted + "." + rel.dotted, pointer: p.pointer + rel.pointer}
+}
+
+// relocateError moves a FieldError built relative to a scope (its rule key)
+// to its full path inside the payload. Other errors are returned unchanged.
+func relocateError(err error, scope fieldPath) error {
+	if fe, ok := err.(*FieldError); ok {
+		fe.setPath(scope.join(fe.rel))
+	}
+	return err
+}
+
+// indexError marks a FieldError as belonging to element i of list field.
+func indexError(err error, field string, i int) error {
+	if fe, ok := err.(*FieldError); ok {
+		fe.rel = fieldPath{}.child(field).index(i)
+		fe.setPath(fe.rel)
+	}
+	return err
+}
@@ -0,0 +1,190 @@
+package map_validator
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"unicode"
+)
+
+// FileRules are the per-file checks of a File() rule (and of every file of a
+// List(File()) rule).
+//
+// Template variables for the CustomMsg file hooks:
+//   - ${actual_size}: file size in bytes (OnFileMinSize, OnFileMaxSize)
+//   - ${expected_min_length} / ${expected_max_length}: the size bounds
+//   - ${mime_type} / ${allowed_types}: sniffed type and AllowedTypes (OnFileType)
+//   - ${extension} / ${allowed_extensions}: file extension and AllowedExtensions (OnFileExtension)
+//   - ${denied_extensions}: DeniedExtensions, when the extension is denied (OnFileExtension)
+type FileRules struct {
+	// MinSize / MaxSize bound FileHeader.Size in bytes; 0 means no limit.
+	MinSize int64
+	MaxSize int64
+	// AllowedTypes lists MIME types detected from the file content with
+	// http.DetectContentType, never from the client's Content-Type. A
+	// trailing wildcard ("image/*") matches a whole family.
+	AllowedTypes []string
+	// AllowedExtensions / DeniedExtensions are matched case-insensitively
+	// against the extension of the uploaded file name, with or without the
+	// leading dot.
+	AllowedExtensions []string
+	DeniedExtensions  []string
+	// SanitizeName returns the file with a copy of its FileHeader whose
+	// Filename is a safe base name (no directories, control or shell
+	// characters) once the checks pass; the request's header is untouched.
+	SanitizeName bool
+	// Image requires the file to be an image; see ImageRules.
+	Image *ImageRules
+}
+
+// fileRules returns a copy so chained Rules values never share FileRules
+func (r Rules) fileRules() FileRules {
+	if r.FileRules == nil {
+		return FileRules{}
+	}
+	return *r.FileRules
+}
+
+func validateFile(data interface{}, validator Rules, field string) (interface{}, error) {
+	if validator.FileRules == nil {
+		return data, nil
+	}
+	var file FileRequest
+	switch v := data.(type) {
+	case FileRequest:
+		file = v
+	case *FileRequest:
+		file = *v
+	}
+	if file.FileInfo == nil {
+		return nil, buildRuleMessage(validator.CustomMsg.OnFile, field, CodeFile, MessageMeta{})
+	}
+	rules := validator.FileRules
+	custom := validator.CustomMsg
+
+	size := file.FileInfo.Size
+	if rules.MinSize > 0 && size < rules.MinSize {
+		return nil, buildRuleMessage(custom.OnFileMinSize, field, CodeFileMinSize, MessageMeta{
+			ExpectedMinLength: SetTotal(rules.MinSize),
+			ActualSize:        &size,
+		})
+	}
+	if rules.MaxSize > 0 && size > rules.MaxSize {
+		return nil, buildRuleMessage(custom.OnFileMaxSize, field, CodeFileMaxSize, MessageMeta{
+			ExpectedMaxLength: SetTotal(rules.MaxSize),
+			ActualSize:        &size,
+		})
+	}
+
+	ext := strings.ToLower(strings.TrimPrefix(filepath.Ext(file.FileInfo.Filename), "."))
+	if len(rules.DeniedExtensions) > 0 && extensionInList(ext, rules.DeniedExtensions) {
+		denied := fmt.Sprintf("%v", rules.DeniedExtensions)
+		meta := MessageMeta{Extension: &ext, DeniedExtensions: &denied}
+		if custom.OnFileExtension != nil {
+			return nil, buildRuleMessage(custom.OnFileExtension, field, CodeFileExtension, meta)
+		}
+		return nil, buildErrorMessageKey(field, CodeFileExtension, MsgExtensionDenied, meta)
+	}
+	if len(rules.AllowedExtensions) > 0 && !extensionInList(ext, rules.AllowedExtensions) {
+		allowed := fmt.Sprintf("%v", rules.AllowedExtensions)
+		return nil, buildRuleMessage(custom.OnFileExtension, field, CodeFileExtension, MessageMeta{
+			Extension:         &ext,
+			AllowedExtensions: &allowed,
+		})
+	}
+
+	if len(rules.AllowedTypes) > 0 {
+		mimeType, err := sniffFileType(file)
+		if err != nil {
+			return nil, err
+		}
+		if !mimeTypeInList(mimeType, rules.AllowedTypes) {
+			allowed := fmt.Sprintf("%v", rules.AllowedTypes)
+			return nil, buildRuleMessage(custom.OnFileType, field, CodeFileType, MessageMeta{
+				MimeType:     &mimeType,
+				AllowedTypes: &allowed,
+			})
+		}
+	}
+
+	if rules.Image != nil {
+		var err error
+		if file, err = validateImage(file, rules.Image, custom, field); err != nil {
+			return nil, err
+		}
+	}
+
+	if rules.SanitizeName {
+		// the header is shared with the request's MultipartForm, rename a copy
+		header := *file.FileInfo
+		header.Filename = sanitizeFilename(header.Filename)
+		file.FileInfo = &header
+	}
+	return file, nil
+}
+
+// sniffFileType detects the MIME type from the first 512 bytes of the file
+// without moving its read offset.
+func sniffFileType(file FileRequest) (string, error) {
+	if file.File == nil {
+		return "application/octet-stream", nil
+	}
+	buf := make([]byte, 512)
+	n, err := file.File.ReadAt(buf, 0)
+	if n == 0 && err != nil && !errors.Is(err, io.EOF) {
+		return "", err
+	}
+	mimeType := http.DetectContentType(buf[:n])
+	if i := strings.Index(mimeType, ";"); i >= 0 {
+		mimeType = mimeType[:i]
+	}
+	return mimeType, nil
+}
+
+func mimeTypeInList(mimeType string, allowed []string) bool {
+	for _, item := range allowed {
+		item = strings.ToLower(strings.TrimSpace(item))
+		if item == mimeType {
+			return true
+		}
+		if strings.HasSuffix(item, "/*") && strings.HasPrefix(mimeType, strings.TrimSuffix(item, "*")) {
+			return true
+		}
+	}
+	return false
+}
+
+func extensionInList(ext string, list []string) bool {
+	for _, item := range list {
+		if strings.ToLower(strings.TrimPrefix(item, ".")) == ext {
+			return true
+		}
+	}
+	return false
+}
+
+// sanitizeFilename keeps the base name and replaces anything outside
+// letters, digits, '.', '-' and '_' with '_'.
+func sanitizeFilename(name string) string {
+	name = filepath.Base(strings.ReplaceAll(name, "\\", "/"))
+	var b strings.Builder
+	for _, r := range name {
+		switch {
+		case r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)), r == '.', r == '-', r == '_':
+			b.WriteRune(r)
+		default:
+			b.WriteRune('_')
+		}
+	}
+	res := strings.TrimLeft(b.String(), ".")
+	if len(res) > 255 {
+		res = res[len(res)-255:]
+	}
+	if res == "" || res == "_" {
+		return "file"
+	}
+	return res
+}
@@ -0,0 +1,128 @@
+package map_validator
+
+import (
+	"errors"
+	"mime/multipart"
+	"reflect"
+	"strings"
+)
+
+var (
+	fileRequestType  = reflect.TypeOf(FileRequest{})
+	fileRequestsType = reflect.TypeOf([]FileRequest{})
+)
+
+// isFileValue reports whether value holds uploaded files (File or
+// List(File()) data)
+func isFileValue(value interface{}) bool {
+	switch v := value.(type) {
+	case FileRequest, *FileRequest:
+		return true
+	case []interface{}:
+		if len(v) == 0 {
+			return false
+		}
+		for _, item := range v {
+			if _, ok := item.(FileRequest); !ok {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+// bindFiles sets uploaded files on the struct (or map) i points to. Struct
+// fields are matched by json tag, then by name, like encoding/json does, and
+// may be FileRequest, *FileRequest or []FileRequest.
+func bindFiles(i interface{}, files map[string]interface{}) error {
+	target := reflect.ValueOf(i)
+	if target.Kind() != reflect.Ptr || target.IsNil() {
+		return errors.New("bind target must be a non-nil pointer")
+	}
+	target = target.Elem()
+	switch target.Kind() {
+	case reflect.Map:
+		if target.Type().Key().Kind() != reflect.String || target.Type().Elem().Kind() != reflect.Interface {
+			return nil
+		}
+		if target.IsNil() {
+			target.Set(reflect.MakeMap(target.Type()))
+		}
+		for key, value := range files {
+			target.SetMapIndex(reflect.ValueOf(key), reflect.ValueOf(value))
+		}
+	case reflect.Struct:
+		for key, value := range files {
+			field, ok := jsonField(target, key)
+			if !ok {
+				continue
+			}
+			setFileField(field, value)
+		}
+	}
+	return nil
+}
+
+func jsonField(target reflect.Value, key string) (reflect.Value, bool) {
+	t := target.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		name := strings.Split(f.Tag.Get("json"), ",")[0]
+		if name == "-" {
+			continue
+		}
+		if name == key || (name == "" && strings.EqualFold(f.Name, key)) {
+			return target.Field(i), true
+		}
+	}
+	return reflect.Value{}, false
+}
+
+func setFileField(field reflect.Value, value interface{}) {
+	var list []FileRequest
+	switch v := value.(type) {
+	case FileRequest:
+		list = []FileRequest{v}
+	case *FileRequest:
+		list = []FileRequest{*v}
+	case []interface{}:
+		for _, item := range v {
+			list = append(list, item.(FileRequest))
+		}
+	}
+	if len(list) == 0 {
+		return
+	}
+	switch {
+	case field.Type() == fileRequestType:
+		field.Set(reflect.ValueOf(list[0]))
+	case field.Type() == reflect.PtrTo(fileRequestType):
+		file := list[0]
+		field.Set(reflect.ValueOf(&file))
+	case field.Type() == fileRequestsType:
+		field.Set(reflect.ValueOf(list))
+	case field.Kind() == reflect.Interface:
+		field.Set(reflect.ValueOf(value))
+	}
+}
+
+// fileRequests is the data produced for a List(File()) rule. When a file
+// cannot be opened the ones opened before it are closed.
+func fileRequests(headers []*multipart.FileHeader) ([]interface{}, error) {
+	res := make([]interface{}, 0, len(headers))
+	for _, header := range headers {
+		file, err := header.Open()
+		if err != nil {
+			for _, opened := range res {
+				_ = opened.(FileRequest).File.Close()
+			}
+			return nil, err
+		}
+		res = append(res, FileRequest{File: file, FileInfo: header})
+	}
+	return res, nil
+}
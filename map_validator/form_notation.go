@@ -0,0 +1,214 @@
+package map_validator
+
+import (
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// decodeFormValues turns flat form keys written in bracket or dot notation
+// into nested maps and slices:
+//
+//	user[name]=a      → {"user": {"name": ["a"]}}
+//	address.city=b    → {"address": {"city": ["b"]}}
+//	items[0][qty]=2   → {"items": [{"qty": ["2"]}]}
+//	tags[]=x&tags[]=y → {"tags": ["x", "y"]}
+//
+// Leaves keep every submitted value as []string; coerceFormValue picks the
+// ones the rule needs. Indexed objects stay maps until formListify turns
+// them into slices.
+func decodeFormValues(form url.Values) map[string]interface{} {
+	root := map[string]interface{}{}
+	for _, key := range sortedKeys(form) {
+		path := splitFormKey(key)
+		if len(path) > 1 && path[len(path)-1] == "" {
+			path = path[:len(path)-1]
+		}
+		if len(path) == 0 {
+			continue
+		}
+		node := root
+		for _, segment := range path[:len(path)-1] {
+			child, ok := node[segment].(map[string]interface{})
+			if !ok {
+				child = map[string]interface{}{}
+				node[segment] = child
+			}
+			node = child
+		}
+		last := path[len(path)-1]
+		if existing, ok := node[last].([]string); ok {
+			node[last] = append(existing, form[key]...)
+		} else if _, ok := node[last].(map[string]interface{}); !ok {
+			node[last] = append([]string{}, form[key]...)
+		}
+	}
+	return root
+}
+
+// splitFormKey splits user[name], items[0].qty or address.city into path
+// segments. An empty segment (tags[]) means "append".
+func splitFormKey(key string) []string {
+	var path []string
+	var current strings.Builder
+	flush := func() {
+		path = append(path, current.String())
+		current.Reset()
+	}
+	inBracket := false
+	for i := 0; i < len(key); i++ {
+		switch c := key[i]; {
+		case c == '[' && !inBracket:
+			if current.Len() > 0 || len(path) == 0 {
+				flush()
+			}
+			inBracket = true
+		case c == ']' && inBracket:
+			flush()
+			inBracket = false
+		case c == '.' && !inBracket:
+			if current.Len() > 0 {
+				flush()
+			}
+		default:
+			current.WriteByte(c)
+		}
+	}
+	if current.Len() > 0 || len(path) == 0 {
+		flush()
+	}
+	if path[0] == "" {
+		return nil
+	}
+	return path
+}
+
+// formListify turns objects whose keys are all indexes into slices. The
+// indexes must run from 0 without gaps (items[0], items[1], ...) so error
+// paths point at the submitted index; a gap is reported as a CodeList
+// error on the list field at path.
+func formListify(node interface{}, field string, path fieldPath) (interface{}, error) {
+	m, ok := node.(map[string]interface{})
+	if !ok {
+		return node, nil
+	}
+	indexes := make([]int, 0, len(m))
+	for _, key := range sortedKeys(m) {
+		index, err := strconv.Atoi(key)
+		isIndex := err == nil && index >= 0
+		if isIndex {
+			indexes = append(indexes, index)
+		}
+		childField, childPath := key, path.child(key)
+		if isIndex {
+			childField, childPath = field, path.index(index)
+		}
+		if m[key], err = formListify(m[key], childField, childPath); err != nil {
+			return nil, err
+		}
+	}
+	if len(m) == 0 || len(indexes) != len(m) {
+		return m, nil
+	}
+	sort.Ints(indexes)
+	list := make([]interface{}, 0, len(indexes))
+	for i, index := range indexes {
+		if index != i {
+			return nil, formIndexError(field, path, i)
+		}
+		list = append(list, m[strconv.Itoa(index)])
+	}
+	return list, nil
+}
+
+// formIndexError reports the list field at path as missing the item at
+// index
+func formIndexError(field string, path fieldPath, index int) *FieldError {
+	missing := int64(index)
+	fe := buildErrorMessageKey(field, CodeList, MsgListIndex, MessageMeta{MissingIndex: &missing})
+	fe.rel = path
+	fe.setPath(path)
+	return fe
+}
+
+// coerceFormValue converts a decoded form value into the data validated
+// against rule, walking NestedObject, ListOfObject and List rules so nested
+// scalars get the declared kind too.
+func coerceFormValue(raw interface{}, rule Rules) interface{} {
+	switch value := raw.(type) {
+	case nil:
+		return nil
+	case []string:
+		if rule.ListObject != nil || rule.Object != nil {
+			return formPlain(value)
+		}
+		return coerceValues(value, rule)
+	case map[string]interface{}:
+		if rule.Object == nil {
+			return formPlain(value)
+		}
+		return coerceFormObject(value, rule.Object)
+	case []interface{}:
+		res := make([]interface{}, 0, len(value))
+		for _, item := range value {
+			switch {
+			case rule.ListObject != nil:
+				if m, ok := item.(map[string]interface{}); ok {
+					res = append(res, coerceFormObject(m, rule.ListObject))
+					continue
+				}
+			case rule.List != nil:
+				if values, ok := item.([]string); ok && len(values) > 0 {
+					res = append(res, coerceString(values[0], rule))
+					continue
+				}
+			}
+			res = append(res, formPlain(item))
+		}
+		return res
+	}
+	return raw
+}
+
+func coerceFormObject(data map[string]interface{}, wrapper RulesWrapper) map[string]interface{} {
+	rules := wrapper.getRules()
+	res := make(map[string]interface{}, len(data))
+	for key, value := range data {
+		if rule, ok := rules[key]; ok {
+			res[key] = coerceFormValue(value, rule)
+		} else {
+			res[key] = formPlain(value)
+		}
+	}
+	return res
+}
+
+// formPlain converts a decoded value without a rule: single values become
+// strings, repeated values lists of strings.
+func formPlain(raw interface{}) interface{} {
+	switch value := raw.(type) {
+	case []string:
+		if len(value) == 1 {
+			return value[0]
+		}
+		res := make([]interface{}, 0, len(value))
+		for _, item := range value {
+			res = append(res, item)
+		}
+		return res
+	case map[string]interface{}:
+		res := make(map[string]interface{}, len(value))
+		for key, item := range value {
+			res[key] = formPlain(item)
+		}
+		return res
+	case []interface{}:
+		res := make([]interface{}, 0, len(value))
+		for _, item := range value {
+			res = append(res, formPlain(item))
+		}
+		return res
+	}
+	return raw
+}
@@ -8,6 +8,7 @@ import (
 	"net"
 	"reflect"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"unicode/utf8"
@@ -15,22 +16,6 @@ import (
 	"github.com/google/uuid"
 )
 
-func isEqualString(current, allowedField string) bool {
-	return current == allowedField
-}
-
-func isEqualFloat64(current, allowedField float64) bool {
-	return current == allowedField
-}
-
-func isEqualInt(current, allowedField int) bool {
-	return current == allowedField
-}
-
-func isEqualInt64(current, allowedField int64) bool {
-	return current == allowedField
-}
-
 func isEmail(email string) bool {
 	ok := strings.Contains(email, "@")
 	if !ok {
@@ -40,15 +25,6 @@ func isEmail(email string) bool {
 	return ok
 }
 
-func valueInList[T any](listData []T, data T, compare func(T, T) bool) bool {
-	for _, currentValue := range listData {
-		if compare(currentValue, data) {
-			return true
-		}
-	}
-	return false
-}
-
 func isIPv4Valid(ip string) bool {
 	parsedIP := net.ParseIP(ip)
 	return parsedIP != nil && parsedIP.To4() != nil
@@ -64,76 +40,186 @@ func isIPv4NetworkValid(ip string) bool {
 	return false
 }
 
-func buildMessage(msg string, meta MessageMeta) error {
-	fieldVar := "${field}"
-	expectedTypeVar := "${expected_type}"
-	actualTypeVar := "${actual_type}"
-	actualLengthVar := "${actual_length}"
-	expectedMinLengthVar := "${expected_min_length}"
-	expectedMaxLengthVar := "${expected_max_length}"
-	uniqueOriginVar := "${unique_origin}"
-	uniqueTargetVar := "${unique_target}"
-	actualValueVar := "${actual_value}"
-	enumValuesVar := "${enum_values}"
-	if strings.Contains(msg, fieldVar) {
-		if meta.Field != nil {
-			v := *meta.Field
-			msg = strings.ReplaceAll(msg, fieldVar, v)
-		}
+// variables returns the template variables available for meta, keyed by
+// name without the ${} wrapper. Unset meta fields are left out so their
+// placeholders stay untouched in the message.
+func (meta MessageMeta) variables() map[string]string {
+	vars := map[string]string{}
+	if meta.Field != nil {
+		vars["field"] = *meta.Field
 	}
-	if strings.Contains(msg, expectedTypeVar) {
-		if meta.ExpectedType != nil {
-			v := *meta.ExpectedType
-			msg = strings.ReplaceAll(msg, expectedTypeVar, v.String())
-		}
+	if meta.ExpectedType != nil {
+		vars["expected_type"] = meta.ExpectedType.String()
 	}
-	if strings.Contains(msg, actualTypeVar) {
-		if meta.ActualType != nil {
-			v := *meta.ActualType
-			msg = strings.ReplaceAll(msg, actualTypeVar, v.String())
-		}
+	if meta.ActualType != nil {
+		vars["actual_type"] = meta.ActualType.String()
 	}
-	if strings.Contains(msg, actualLengthVar) {
-		if meta.ActualLength != nil {
-			v := *meta.ActualLength
-			msg = strings.ReplaceAll(msg, actualLengthVar, fmt.Sprintf("%v", v))
-		}
+	if meta.ActualLength != nil {
+		vars["actual_length"] = fmt.Sprintf("%v", *meta.ActualLength)
 	}
-	if strings.Contains(msg, expectedMinLengthVar) {
-		if meta.ExpectedMinLength != nil {
-			v := *meta.ExpectedMinLength
-			msg = strings.ReplaceAll(msg, expectedMinLengthVar, fmt.Sprintf("%v", v))
-		}
+	if meta.ExpectedMinLength != nil {
+		vars["expected_min_length"] = fmt.Sprintf("%v", *meta.ExpectedMinLength)
 	}
-	if strings.Contains(msg, expectedMaxLengthVar) {
-		if meta.ExpectedMaxLength != nil {
-			v := *meta.ExpectedMaxLength
-			msg = strings.ReplaceAll(msg, expectedMaxLengthVar, fmt.Sprintf("%v", v))
-		}
+	if meta.ExpectedMaxLength != nil {
+		vars["expected_max_length"] = fmt.Sprintf("%v", *meta.ExpectedMaxLength)
 	}
-	if strings.Contains(msg, uniqueOriginVar) {
-		if meta.Field != nil {
-			v := *meta.UniqueOrigin
-			msg = strings.ReplaceAll(msg, uniqueOriginVar, v)
-		}
+	if meta.UniqueOrigin != nil {
+		vars["unique_origin"] = *meta.UniqueOrigin
 	}
-	if strings.Contains(msg, uniqueTargetVar) {
-		if meta.Field != nil {
-			v := *meta.UniqueTarget
-			msg = strings.ReplaceAll(msg, uniqueTargetVar, v)
-		}
+	if meta.UniqueTarget != nil {
+		vars["unique_target"] = *meta.UniqueTarget
 	}
-	if strings.Contains(msg, actualValueVar) {
-		if meta.ActualValue != nil {
-			msg = strings.ReplaceAll(msg, actualValueVar, *meta.ActualValue)
-		}
+	if meta.ActualValue != nil {
+		vars["actual_value"] = *meta.ActualValue
+	}
+	if meta.EnumValues != nil {
+		vars["enum_values"] = *meta.EnumValues
+	}
+	if meta.Dependencies != nil {
+		vars["dependencies"] = *meta.Dependencies
+	}
+	if meta.UnknownKey != nil {
+		vars["unknown_key"] = *meta.UnknownKey
+	}
+	if meta.Source != nil {
+		vars["source"] = *meta.Source
+	}
+	if meta.ActualSize != nil {
+		vars["actual_size"] = fmt.Sprintf("%v", *meta.ActualSize)
+	}
+	if meta.MimeType != nil {
+		vars["mime_type"] = *meta.MimeType
+	}
+	if meta.AllowedTypes != nil {
+		vars["allowed_types"] = *meta.AllowedTypes
+	}
+	if meta.Extension != nil {
+		vars["extension"] = *meta.Extension
+	}
+	if meta.AllowedExtensions != nil {
+		vars["allowed_extensions"] = *meta.AllowedExtensions
+	}
+	if meta.DeniedExtensions != nil {
+		vars["denied_extensions"] = *meta.DeniedExtensions
+	}
+	if meta.ImageFormat != nil {
+		vars["image_format"] = *meta.ImageFormat
+	}
+	if meta.ImageWidth != nil {
+		vars["image_width"] = fmt.Sprintf("%v", *meta.ImageWidth)
+	}
+	if meta.ImageHeight != nil {
+		vars["image_height"] = fmt.Sprintf("%v", *meta.ImageHeight)
+	}
+	if meta.AspectRatio != nil {
+		vars["aspect_ratio"] = *meta.AspectRatio
+	}
+	if meta.ExpectedRatio != nil {
+		vars["expected_aspect_ratio"] = *meta.ExpectedRatio
+	}
+	if meta.ActualNumber != nil {
+		vars["actual_value"] = fmt.Sprintf("%v", meta.ActualNumber)
+		vars["actual_length"] = vars["actual_value"]
+	}
+	if meta.ExpectedMinNumber != nil {
+		vars["expected_min_length"] = formatFloat(*meta.ExpectedMinNumber)
+	}
+	if meta.ExpectedMaxNumber != nil {
+		vars["expected_max_length"] = formatFloat(*meta.ExpectedMaxNumber)
+	}
+	if meta.MultipleOf != nil {
+		vars["multiple_of"] = formatFloat(*meta.MultipleOf)
+	}
+	if meta.FirstIndex != nil {
+		vars["first_index"] = fmt.Sprintf("%v", *meta.FirstIndex)
+	}
+	if meta.DuplicateIndex != nil {
+		vars["duplicate_index"] = fmt.Sprintf("%v", *meta.DuplicateIndex)
+	}
+	if meta.MissingIndex != nil {
+		vars["missing_index"] = fmt.Sprintf("%v", *meta.MissingIndex)
+	}
+	if meta.UniqueKeys != nil {
+		vars["unique_keys"] = *meta.UniqueKeys
+	}
+	if meta.MatchedBranches != nil {
+		vars["matched_branches"] = *meta.MatchedBranches
+	}
+	if meta.CompareField != nil {
+		vars["compare_field"] = *meta.CompareField
+	}
+	if meta.CompareValue != nil {
+		vars["compare_value"] = *meta.CompareValue
+	}
+	if meta.Condition != nil {
+		vars["condition"] = *meta.Condition
+	}
+	if meta.ConditionField != nil {
+		vars["condition_field"] = *meta.ConditionField
+	}
+	if meta.ConditionValues != nil {
+		vars["condition_values"] = *meta.ConditionValues
+	}
+	return vars
+}
+
+// buildMessage renders a CustomMsg template into a FieldError
+func buildMessage(code string, msg string, meta MessageMeta) *FieldError {
+	fe := newFieldError(code, meta)
+	fe.template = msg
+	fe.render()
+	return fe
+}
+
+// buildRuleMessage renders the CustomMsg template custom when it is set and
+// the catalog message for code otherwise
+func buildRuleMessage(custom *string, field, code string, meta MessageMeta) *FieldError {
+	if custom != nil {
+		meta.Field = &field
+		return buildMessage(code, *custom, meta)
+	}
+	return buildErrorMessage(field, code, meta)
+}
+
+// listObjectError reports a ListObject value that is not a list of objects
+func listObjectError(custom *string, field string) *FieldError {
+	if custom != nil {
+		return buildMessage(CodeList, *custom, MessageMeta{Field: &field})
+	}
+	return buildErrorMessageKey(field, CodeList, MsgListObject, MessageMeta{})
+}
+
+// actualValueMeta exposes a string value as ${actual_value}
+func actualValueMeta(data interface{}) MessageMeta {
+	if str, ok := data.(string); ok {
+		return MessageMeta{ActualValue: &str}
 	}
-	if strings.Contains(msg, enumValuesVar) {
-		if meta.EnumValues != nil {
-			msg = strings.ReplaceAll(msg, enumValuesVar, *meta.EnumValues)
+	return MessageMeta{}
+}
+
+// dependencyMessage returns the first template set by pick on the rules of
+// the declaring fields
+func dependencyMessage(wrapper RulesWrapper, fields []string, pick func(CustomMsg) *string) *string {
+	if wrapper == nil {
+		return nil
+	}
+	for _, field := range fields {
+		if rule, ok := wrapper.getRules()[field]; ok {
+			if msg := pick(rule.CustomMsg); msg != nil {
+				return msg
+			}
 		}
 	}
-	return errors.New(msg)
+	return nil
+}
+
+// buildCatalogMessage renders the catalog entry key as a whole sentence
+// (no field prefix), e.g. required or strict-key messages
+func buildCatalogMessage(code, key string, meta MessageMeta) *FieldError {
+	fe := newFieldError(code, meta)
+	fe.key = key
+	fe.render()
+	return fe
 }
 
 // wrapperRunState holds the mutable per-scope state used during a single
@@ -145,12 +231,40 @@ type wrapperRunState struct {
 	nullFields      []string
 	requiredWithout map[string][]string
 	requiredIf      map[string][]string
+	strictChecked   bool
+	// path is where this scope sits inside the payload
+	path fieldPath
+	// collectErrors makes validateRecursive record failures on the chain
+	// instead of returning the first one. It is inherited by nested scopes.
+	collectErrors bool
 }
 
 func newWrapperRunState() *wrapperRunState {
 	return &wrapperRunState{}
 }
 
+// nested returns a fresh state for a child scope (Object or ListObject item)
+// at path that keeps the run-wide options of its parent.
+func (state *wrapperRunState) nested(path fieldPath) *wrapperRunState {
+	child := newWrapperRunState()
+	child.path = path
+	if state != nil {
+		child.collectErrors = state.collectErrors
+	}
+	return child
+}
+
+func (state *wrapperRunState) scope() fieldPath {
+	if state == nil {
+		return fieldPath{}
+	}
+	return state.path
+}
+
+func (state *wrapperRunState) collecting() bool {
+	return state != nil && state.collectErrors
+}
+
 func validateRecursive(pChain ChainerType, wrapper RulesWrapper, state *wrapperRunState, key string, data map[string]interface{}, rule Rules, loadedFrom loadFromType) (interface{}, error) {
 	//child and parent chain
 	var res interface{}
@@ -162,22 +276,44 @@ func validateRecursive(pChain ChainerType, wrapper RulesWrapper, state *wrapperR
 	}
 	cChain := pChain.AddChild().SetKey(nodeKey)
 	var endOfLoop bool
-	if wrapper != nil && wrapper.getSetting().Strict {
-		var allowedKeys []string
-		keys := getAllKeys(data)
-		for XKey, _ := range wrapper.getRules() {
-			allowedKeys = append(allowedKeys, XKey)
-		}
-		for _, XKey := range keys {
-			if !isDataInList(XKey, allowedKeys) {
-				return nil, fmt.Errorf("'%s' is not allowed key", XKey)
+	if wrapper != nil && wrapper.getSetting().Strict && (state == nil || !state.strictChecked) {
+		if state != nil {
+			state.strictChecked = true
+		}
+		rules := wrapper.getRules()
+		for _, XKey := range sortedKeys(data) {
+			if _, ok := rules[XKey]; ok {
+				continue
+			}
+			unknownKey := XKey
+			unknownMeta := MessageMeta{Field: &unknownKey, UnknownKey: &unknownKey}
+			var unknownErr *FieldError
+			if msg := wrapper.getSetting().OnUnknownKey; msg != nil {
+				unknownErr = buildMessage(CodeStrictUnknownKey, *msg, unknownMeta)
+			} else {
+				unknownErr = buildCatalogMessage(CodeStrictUnknownKey, CodeStrictUnknownKey, unknownMeta)
+			}
+			err = relocateError(unknownErr, state.scope())
+			if !state.collecting() {
+				return nil, err
 			}
+			pChain.AddError(err)
 		}
 	}
 
 	res, err = validate(key, data, rule, loadedFrom)
 	if err != nil {
-		return nil, err
+		if !state.collecting() {
+			return nil, relocateError(firstError(err), state.scope())
+		}
+		// a primitive list reports every failed element
+		elementErrs, ok := err.(ValidationErrors)
+		if !ok {
+			elementErrs = ValidationErrors{err}
+		}
+		for _, elementErr := range elementErrs {
+			cChain.AddError(relocateError(elementErr, state.scope()))
+		}
 	}
 
 	if res != nil {
@@ -190,12 +326,19 @@ func validateRecursive(pChain ChainerType, wrapper RulesWrapper, state *wrapperR
 			cChain.SetUniques(rule.Unique)
 		}
 
+		// add sibling comparisons
+		if c, ok := cChain.(compareChainer); ok && res != nil && len(rule.Compare) > 0 {
+			c.setCompares(rule.Compare)
+		}
+
 		// add custom message values
 		if res != nil && rule.CustomMsg.isNotNil() {
 			cChain.SetCustomMsg(&rule.CustomMsg)
 		}
 
-		if res != nil {
+		// a field that failed validation still counts as filled when it was
+		// sent, so conditional-required checks see the payload as it is
+		if res != nil || (err != nil && data[key] != nil) {
 			state.filledField = append(state.filledField, key)
 		} else {
 			state.nullFields = append(state.nullFields, key)
@@ -235,7 +378,18 @@ func validateRecursive(pChain ChainerType, wrapper RulesWrapper, state *wrapperR
 				}
 			}
 			if !required {
-				return nil, fmt.Errorf("if field '%s' is null you need to put value in %v field", field, dependenciesField)
+				dependencies := fmt.Sprintf("%v", dependenciesField)
+				reqMeta := MessageMeta{Field: &field, Dependencies: &dependencies}
+				reqErr := buildCatalogMessage(CodeRequiredWithout, CodeRequiredWithout, reqMeta)
+				if msg := dependencyMessage(wrapper, dependenciesField, func(cm CustomMsg) *string { return cm.OnRequiredWithout }); msg != nil {
+					reqErr = buildMessage(CodeRequiredWithout, *msg, reqMeta)
+				}
+				reqErr.Expected = dependenciesField
+				reqErr.setPath(state.scope().child(field))
+				if !state.collecting() {
+					return nil, reqErr
+				}
+				pChain.AddError(reqErr)
 			}
 		}
 	}
@@ -263,16 +417,28 @@ func validateRecursive(pChain ChainerType, wrapper RulesWrapper, state *wrapperR
 				}
 			}
 			if !required {
-				return nil, fmt.Errorf("if field '%s' is filled you need to put value in %v field also", field, dependenciesField)
+				dependencies := fmt.Sprintf("%v", dependenciesField)
+				reqMeta := MessageMeta{Field: &field, Dependencies: &dependencies}
+				reqErr := buildCatalogMessage(CodeRequiredIf, CodeRequiredIf, reqMeta)
+				if msg := dependencyMessage(wrapper, dependenciesField, func(cm CustomMsg) *string { return cm.OnRequiredIf }); msg != nil {
+					reqErr = buildMessage(CodeRequiredIf, *msg, reqMeta)
+				}
+				reqErr.Expected = dependenciesField
+				reqErr.setPath(state.scope().child(field))
+				if !state.collecting() {
+					return nil, reqErr
+				}
+				pChain.AddError(reqErr)
 			}
 		}
 	}
 
 	// if list
 	if rule.Object != nil && res != nil {
-		innerState := newWrapperRunState()
-		for keyX, ruleX := range rule.Object.getRules() {
-			_, err = validateRecursive(cChain, rule.Object, innerState, keyX, res.(map[string]interface{}), ruleX, fromJSONEncoder)
+		innerState := state.nested(state.scope().child(key))
+		objectRules := rule.Object.getRules()
+		for _, keyX := range sortedKeys(objectRules) {
+			_, err = validateRecursive(cChain, rule.Object, innerState, keyX, res.(map[string]interface{}), objectRules[keyX], fromJSONEncoder)
 			if err != nil {
 				return nil, err
 			}
@@ -282,39 +448,68 @@ func validateRecursive(pChain ChainerType, wrapper RulesWrapper, state *wrapperR
 	if rule.ListObject != nil && res != nil {
 		listRes := res.([]interface{})
 		var manipulated []interface{}
-		for _, xRes := range listRes {
+		// validated items by index, for the UniqueKeys check
+		checked := make([]interface{}, len(listRes))
+		itemRules := rule.ListObject.getRules()
+		for i, xRes := range listRes {
 			if m, ok := xRes.(map[string]interface{}); ok {
 				// Validate as object with the provided child rules
 				tmpChain := newChainer().SetKey(chainKey)
-				itemState := newWrapperRunState()
-				for keyX, ruleX := range rule.ListObject.getRules() {
-					_, err = validateRecursive(tmpChain, rule.ListObject, itemState, keyX, m, ruleX, fromJSONEncoder)
+				tmpChain.(*chainState).scope = state.scope().child(key).index(i)
+				itemState := state.nested(state.scope().child(key).index(i))
+				for _, keyX := range sortedKeys(itemRules) {
+					_, err = validateRecursive(tmpChain, rule.ListObject, itemState, keyX, m, itemRules[keyX], fromJSONEncoder)
 					if err != nil {
 						return nil, err
 					}
 				}
+				// the item chain is detached from the main tree, so run its
+				// comparisons here and carry its failures over to the list node
+				if c, ok := tmpChain.(compareChainer); ok {
+					c.runCompareChecker()
+				}
+				for _, itemErr := range tmpChain.GetResult().GetErrors() {
+					cChain.AddError(itemErr)
+				}
 				// collect validated/manipulated item data back into the slice
 				itemMapFull := tmpChain.GetResult().ToMap()
 				filtered := make(map[string]interface{})
-				for keyAllowed := range rule.ListObject.getRules() {
+				for keyAllowed := range itemRules {
 					if val, ok := itemMapFull[keyAllowed]; ok {
 						filtered[keyAllowed] = val
 					}
 				}
 				manipulated = append(manipulated, filtered)
+				checked[i] = filtered
 			} else {
 				// Fallback: treat as primitive element; validate against parent rule flags (e.g., UUID, Email)
 				tmpRule := rule
 				tmpRule.Object = nil
 				tmpRule.ListObject = nil
 				tmpRule.List = nil
+				tmpRule.RequiredWhen = nil
+				tmpRule.ForbiddenWhen = nil
 				tmpPayload := map[string]interface{}{key: xRes}
 				if _, err := validate(key, tmpPayload, tmpRule, fromJSONEncoder); err != nil {
-					return nil, err
+					err = relocateError(indexError(err, key, i), state.scope())
+					if !state.collecting() {
+						return nil, err
+					}
+					cChain.AddError(err)
+					continue
 				}
 				manipulated = append(manipulated, xRes)
 			}
 		}
+		if len(rule.UniqueKeys) > 0 {
+			if first, dup, found := duplicateItems(checked, rule.UniqueKeys); found {
+				err = relocateError(uniqueItemsError(rule.CustomMsg, key, rule.UniqueKeys, first, dup), state.scope())
+				if !state.collecting() {
+					return nil, err
+				}
+				cChain.AddError(err)
+			}
+		}
 		cChain.SetValue(manipulated)
 	}
 
@@ -331,7 +526,8 @@ func ValidateValue(data interface{}, validator Rules, dataFrom LoadFromType, fie
 		fieldName = field[0]
 	}
 
-	return validateValueInternal(data, validator, loadFromType(dataFrom), fieldName)
+	res, err := validateValueInternal(data, validator, loadFromType(dataFrom), fieldName)
+	return res, firstError(err)
 }
 
 // validate is the core field validator used across the package and tests
@@ -344,27 +540,39 @@ func validate(field string, dataTemp map[string]interface{}, validator Rules, da
 	// Extract the data value for the field
 	data := dataTemp[field]
 
+	// RequiredWhen / ForbiddenWhen look at the sibling values as sent
+	if len(validator.RequiredWhen) > 0 {
+		if c, ok := matchingCondition(validator.RequiredWhen, dataTemp); ok && data == nil {
+			return nil, conditionError(CodeRequiredWhen, validator.CustomMsg.OnRequiredWhen, field, c, dataTemp)
+		}
+		validator.Null = true
+	}
+	if c, ok := matchingCondition(validator.ForbiddenWhen, dataTemp); ok {
+		if data != nil {
+			return nil, conditionError(CodeForbiddenWhen, validator.CustomMsg.OnForbiddenWhen, field, c, dataTemp)
+		}
+		return nil, nil
+	}
+
 	// Use the internal validation function
 	return validateValueInternal(data, validator, dataFrom, field)
 }
 
 // buildErrorMessage creates a natural error message based on field name
-func buildErrorMessage(field string, message string) error {
-	if field == "value" {
-		// For default field, use more natural message without "field" prefix
-		return errors.New(message)
-	}
-	// For specific fields, use the traditional format
-	return errors.New("the field '" + field + "' " + message)
+func buildErrorMessage(field, code string, meta MessageMeta) *FieldError {
+	return buildErrorMessageKey(field, code, code, meta)
 }
 
-// buildErrorMessagef creates a natural error message with formatting
-func buildErrorMessagef(field string, format string, args ...interface{}) error {
-	message := fmt.Sprintf(format, args...)
-	if field == "value" {
-		return errors.New(message)
-	}
-	return fmt.Errorf("the field '%s' "+format, append([]interface{}{field}, args...)...)
+// buildErrorMessageKey is buildErrorMessage with a catalog key that differs
+// from the rule code
+func buildErrorMessageKey(field, code, key string, meta MessageMeta) *FieldError {
+	meta.Field = &field
+	fe := newFieldError(code, meta)
+	fe.key = key
+	// For default field, use more natural message without "field" prefix
+	fe.prefixed = field != "value"
+	fe.render()
+	return fe
 }
 
 // validateValueInternal contains the actual validation logic
@@ -373,10 +581,13 @@ func validateValueInternal(data interface{}, validator Rules, dataFrom loadFromT
 
 	// null validation
 	if !validator.Null && data == nil {
+		if validator.CustomMsg.OnNull != nil {
+			return nil, buildMessage(CodeRequired, *validator.CustomMsg.OnNull, MessageMeta{Field: &field})
+		}
 		if field == "value" {
-			return nil, errors.New("value is required")
+			return nil, buildCatalogMessage(CodeRequired, MsgRequiredValue, MessageMeta{Field: &field})
 		}
-		return nil, errors.New("we need '" + field + "' field")
+		return nil, buildCatalogMessage(CodeRequired, CodeRequired, MessageMeta{Field: &field})
 	} else if validator.Null && data == nil {
 		if !validator.NilIfNull && validator.IfNull != nil {
 			return validator.IfNull, nil
@@ -394,6 +605,17 @@ func validateValueInternal(data interface{}, validator Rules, dataFrom loadFromT
 		return data, nil
 	}
 
+	if validator.hasCombinator() && validator.List == nil && validator.ListObject == nil {
+		return validateCombinators(data, validator, dataFrom, field)
+	}
+
+	if num, ok := data.(json.Number); ok {
+		var err error
+		if data, err = normalizeJSONNumber(num, validator, field); err != nil {
+			return nil, err
+		}
+	}
+
 	//if validator.ListObject != nil {
 	//	res, err := toInterfaceSlice(data)
 	//	if err != nil {
@@ -414,16 +636,17 @@ func validateValueInternal(data interface{}, validator Rules, dataFrom loadFromT
 	if validator.ListObject != nil && validator.List == nil {
 		s, ok := toInterfaceSlice(data)
 		if !ok {
-			return nil, buildErrorMessage(field, "is not valid list object")
+			return nil, listObjectError(validator.CustomMsg.OnList, field)
 		}
 		return s, nil
 	}
 
 	// validatorType type validation
 	dataType := reflect.TypeOf(data).Kind()
-	handleIntOnHttpJson := (dataFrom == fromHttpJson || dataFrom == fromJSONEncoder) && isIntegerFamily(validator.Type) && isIntegerFamily(dataType)
+	handleIntOnHttpJson := isJSONLike(dataFrom) && isIntegerFamily(validator.Type) && isIntegerFamily(dataType)
 	customData := !(!validator.UUID &&
 		!validator.IPV4 &&
+		!validator.IPV4Network &&
 		!validator.UUIDToString &&
 		!validator.IPv4OptionalPrefix &&
 		!validator.Email &&
@@ -440,24 +663,36 @@ func validateValueInternal(data interface{}, validator Rules, dataFrom loadFromT
 	//}
 
 	if dataType != validator.Type && !customData && !handleIntOnHttpJson {
-		if (dataFrom == fromHttpJson || dataFrom == fromJSONEncoder) && isIntegerFamily(validator.Type) {
+		if isJSONLike(dataFrom) && isIntegerFamily(validator.Type) {
 			validator.Type = reflect.Int
 		}
+		typeMeta := MessageMeta{
+			Field:        &field,
+			ExpectedType: &validator.Type,
+			ActualType:   &dataType,
+		}
+		if validator.List != nil && validator.CustomMsg.OnList != nil {
+			return nil, buildMessage(CodeList, *validator.CustomMsg.OnList, typeMeta)
+		}
 		if validator.CustomMsg.OnTypeNotMatch != nil {
-			return nil, buildMessage(*validator.CustomMsg.OnTypeNotMatch, MessageMeta{
-				Field:        &field,
-				ExpectedType: &validator.Type,
-				ActualType:   &dataType,
-			})
+			return nil, buildMessage(CodeType, *validator.CustomMsg.OnTypeNotMatch, typeMeta)
+		}
+		return nil, buildErrorMessage(field, CodeType, typeMeta)
+	}
+
+	// Integer kinds only take whole numbers that fit the kind; JSON gives
+	// every number as float64.
+	if validator.List == nil && validator.ListObject == nil {
+		if err := integerError(data, numberKind(validator), validator, field); err != nil {
+			return nil, err
 		}
-		return nil, buildErrorMessage(field, "should be '"+validator.Type.String()+"'")
 	}
 
 	// Early list handling to avoid container-level regex/enum/type checks
 	if validator.List != nil {
 		sliceDataX, ok := toInterfaceSlice(data)
 		if !ok {
-			return nil, buildErrorMessage(field, "is not valid list")
+			return nil, buildRuleMessage(validator.CustomMsg.OnList, field, CodeList, MessageMeta{})
 		}
 
 		// List of objects via Object rules or legacy ListObject
@@ -466,7 +701,7 @@ func validateValueInternal(data interface{}, validator Rules, dataFrom loadFromT
 			if validator.Object != nil {
 				for _, it := range sliceDataX {
 					if _, ok := it.(map[string]interface{}); !ok {
-						return nil, buildErrorMessage(field, "is not valid list object")
+						return nil, listObjectError(validator.CustomMsg.OnList, field)
 					}
 				}
 			}
@@ -480,131 +715,27 @@ func validateValueInternal(data interface{}, validator Rules, dataFrom loadFromT
 			elementMinPtr = lr.ListRules.Min
 			elementMaxPtr = lr.ListRules.Max
 		}
-		for _, it := range sliceDataX {
-			tmpRule := validator
-			tmpRule.List = nil
-			tmpRule.ListObject = nil
-			tmpRule.Object = nil
-			// restore element type for per-item validation
-			tmpRule.Type = originalElementKind
-			// By default, do not carry container Min/Max into element checks
-			tmpRule.Min = nil
-			tmpRule.Max = nil
-			// Apply element content constraints (pre-check) for string and numeric elements
-			if it != nil {
-				gotKind := reflect.TypeOf(it).Kind()
-				// Resolve effective element kind: explicit Type if provided, else infer from value
-				effectiveKind := originalElementKind
-				if effectiveKind == reflect.Invalid {
-					effectiveKind = gotKind
-				}
-				// String length constraints
-				if effectiveKind == reflect.String && gotKind == reflect.String {
-					if elementMinPtr != nil {
-						actualLen := int64(utf8.RuneCountInString(it.(string)))
-						if actualLen < *elementMinPtr {
-							if validator.CustomMsg.OnMin != nil {
-								return nil, buildMessage(*validator.CustomMsg.OnMin, MessageMeta{
-									Field:             &field,
-									ExpectedMinLength: elementMinPtr,
-									ActualLength:      &actualLen,
-								})
-							}
-							return nil, buildErrorMessagef(field, "should be or greater than %v", *elementMinPtr)
-						}
-					}
-					if elementMaxPtr != nil {
-						actualLen := int64(utf8.RuneCountInString(it.(string)))
-						if actualLen > *elementMaxPtr {
-							if validator.CustomMsg.OnMax != nil {
-								return nil, buildMessage(*validator.CustomMsg.OnMax, MessageMeta{
-									Field:             &field,
-									ExpectedMaxLength: elementMaxPtr,
-									ActualLength:      &actualLen,
-								})
-							}
-							return nil, buildErrorMessagef(field, "should be or lower than %v", *elementMaxPtr)
-						}
-					}
-				}
-				// Numeric value constraints
-				if isIntegerFamily(effectiveKind) && isIntegerFamily(gotKind) {
-					// normalize to float64 for comparison
-					var num float64
-					switch v := it.(type) {
-					case int:
-						num = float64(v)
-					case int8:
-						num = float64(v)
-					case int16:
-						num = float64(v)
-					case int32:
-						num = float64(v)
-					case int64:
-						num = float64(v)
-					case uint:
-						num = float64(v)
-					case uint8:
-						num = float64(v)
-					case uint16:
-						num = float64(v)
-					case uint32:
-						num = float64(v)
-					case uint64:
-						num = float64(v)
-					case float32:
-						num = float64(v)
-					case float64:
-						num = v
-					default:
-						// fallback: let validate handle
-						num = 0
-					}
-					if elementMinPtr != nil && num < float64(*elementMinPtr) {
-						if validator.CustomMsg.OnMin != nil {
-							actualLen := int64(num)
-							return nil, buildMessage(*validator.CustomMsg.OnMin, MessageMeta{
-								Field:             &field,
-								ExpectedMinLength: elementMinPtr,
-								ActualLength:      &actualLen,
-							})
-						}
-						return nil, buildErrorMessagef(field, "should be or greater than %v", *elementMinPtr)
-					}
-					if elementMaxPtr != nil && num > float64(*elementMaxPtr) {
-						if validator.CustomMsg.OnMax != nil {
-							actualLen := int64(num)
-							return nil, buildMessage(*validator.CustomMsg.OnMax, MessageMeta{
-								Field:             &field,
-								ExpectedMaxLength: elementMaxPtr,
-								ActualLength:      &actualLen,
-							})
-						}
-						return nil, buildErrorMessagef(field, "should be or lower than %v", *elementMaxPtr)
-					}
-				}
-			}
-			// Pre-check element type mismatch to craft a clearer wording
-			// Only when element Type is explicitly set (avoid interfering with Enum/UUID/Regex-only rules)
-			if it != nil && tmpRule.Type != reflect.Invalid {
-				gotKind := reflect.TypeOf(it).Kind()
-				expectedKind := tmpRule.Type
-				allowIntCoerce := (dataFrom == fromHttpJson || dataFrom == fromJSONEncoder) && isIntegerFamily(expectedKind) && isIntegerFamily(gotKind)
-				if gotKind != expectedKind && !allowIntCoerce {
-					// Map kind to human-friendly noun (e.g., int/uint/float -> integer)
-					noun := expectedKind.String()
-					if isIntegerFamily(expectedKind) {
-						noun = "integer"
-					}
-					return nil, buildErrorMessagef(field, "should be %s", noun)
-				}
+		// every element is checked so a collecting run can report them all;
+		// validateRecursive keeps the first one otherwise
+		var elementErrs ValidationErrors
+		for i, it := range sliceDataX {
+			res, err := validateListElement(it, validator, originalElementKind, elementMinPtr, elementMaxPtr, dataFrom, field)
+			if err != nil {
+				elementErrs = append(elementErrs, indexError(firstError(err), field, i))
+				continue
 			}
-
-			// Recursive validation for each element
-			if _, err := validateValueInternal(it, tmpRule, dataFrom, field); err != nil {
-				return nil, err
+			if _, isNumber := it.(json.Number); isNumber || validator.File || (validator.Enum != nil && validator.Enum.Canonical) {
+				// keep normalized numbers, canonical enum items and what
+				// the file checks add (sanitized name, image info)
+				sliceDataX[i] = res
 			}
 		}
+		if len(elementErrs) == 1 {
+			return nil, elementErrs[0]
+		}
+		if len(elementErrs) > 1 {
+			return nil, elementErrs
+		}
 		// list-size Min/Max come from outer rule (container size)
 		var minPtr, maxPtr *int64
 		if validator.Min != nil {
@@ -615,25 +746,36 @@ func validateValueInternal(data interface{}, validator Rules, dataFrom loadFromT
 		}
 		listLen := int64(len(sliceDataX))
 		if minPtr != nil && listLen < *minPtr {
-			return nil, buildErrorMessagef(field, "should be or greater than %v", *minPtr)
+			return nil, buildErrorMessage(field, CodeMin, MessageMeta{
+				ExpectedMinLength: minPtr,
+				ActualLength:      &listLen,
+			})
 		}
 		if maxPtr != nil && listLen > *maxPtr {
-			return nil, buildErrorMessagef(field, "should be or lower than %v", *maxPtr)
+			return nil, buildErrorMessage(field, CodeMax, MessageMeta{
+				ExpectedMaxLength: maxPtr,
+				ActualLength:      &listLen,
+			})
+		}
+		if validator.listRules().Unique {
+			if first, dup, found := duplicateItems(sliceDataX, nil); found {
+				return nil, uniqueItemsError(validator.CustomMsg, field, nil, first, dup)
+			}
 		}
 		return sliceDataX, nil
 	}
 
 	if validator.File {
 		//this will return FileRequest
-		return data, nil
+		return validateFile(data, validator, field)
 	}
 
 	if validator.RegexString != "" {
 		if dataType != reflect.String {
 			if validator.CustomMsg.OnRegexString != nil {
-				return nil, buildMessage(*validator.CustomMsg.OnRegexString, MessageMeta{Field: &field})
+				return nil, buildMessage(CodeRegex, *validator.CustomMsg.OnRegexString, MessageMeta{Field: &field})
 			}
-			return nil, buildErrorMessage(field, "should be string")
+			return nil, buildErrorMessageKey(field, CodeRegex, MsgTypeString, MessageMeta{ActualType: &dataType})
 		}
 		regex, err := regexp.Compile(validator.RegexString)
 		if err != nil {
@@ -641,45 +783,46 @@ func validateValueInternal(data interface{}, validator Rules, dataFrom loadFromT
 		}
 		if !regex.MatchString(data.(string)) {
 			if validator.CustomMsg.OnRegexString != nil {
-				return nil, buildMessage(*validator.CustomMsg.OnRegexString, MessageMeta{Field: &field})
+				return nil, buildMessage(CodeRegex, *validator.CustomMsg.OnRegexString, MessageMeta{Field: &field})
 			}
-			return nil, buildErrorMessage(field, "is not valid regex")
+			actualValue := data.(string)
+			return nil, buildErrorMessage(field, CodeRegex, MessageMeta{ActualValue: &actualValue})
 		}
 		return data, nil
 	}
 
 	// Helper function to build enum error message with custom or default text
 	buildEnumErrorMessage := func(enumValues interface{}, enumType reflect.Type, actualType reflect.Kind) error {
+		expectedType := enumType.Elem().Kind()
+		actualValue := fmt.Sprintf("%v", data)
+		enumValuesStr := fmt.Sprintf("%v", enumValues)
+		enumMeta := MessageMeta{
+			Field:        &field,
+			ExpectedType: &expectedType,
+			ActualType:   &actualType,
+			ActualValue:  &actualValue,
+			EnumValues:   &enumValuesStr,
+		}
 		if validator.CustomMsg.OnEnumValueNotMatch != nil {
-			expectedType := enumType.Elem().Kind()
-			actualValue := fmt.Sprintf("%v", data)
-			enumValuesStr := fmt.Sprintf("%v", enumValues)
-			return buildMessage(*validator.CustomMsg.OnEnumValueNotMatch, MessageMeta{
-				Field:        &field,
-				ExpectedType: &expectedType,
-				ActualType:   &actualType,
-				ActualValue:  &actualValue,
-				EnumValues:   &enumValuesStr,
-			})
+			return buildMessage(CodeEnum, *validator.CustomMsg.OnEnumValueNotMatch, enumMeta)
 		}
-		return buildErrorMessagef(field, "value is not in enum list%v", enumValues)
+		return buildErrorMessage(field, CodeEnum, enumMeta)
 	}
 
 	if validator.Enum != nil {
 		enumType := reflect.TypeOf(validator.Enum.Items)
 		if enumType.Kind() == reflect.Slice {
-			enumValue := reflect.ValueOf(validator.Enum.Items)
 			// Handle integer family coercion for HTTP JSON like regular type validation
 			if dataType != enumType.Elem().Kind() {
 				// Allow type mismatch for integer family from HTTP JSON
-				if (dataFrom == fromHttpJson || dataFrom == fromJSONEncoder) &&
+				if isJSONLike(dataFrom) &&
 					isIntegerFamily(enumType.Elem().Kind()) && isIntegerFamily(dataType) {
 					// Type coercion will be handled in the switch cases below
 				} else {
 					// Use custom message for type mismatch if available, otherwise use custom enum message or default
 					if validator.CustomMsg.OnTypeNotMatch != nil {
 						expectedType := enumType.Elem().Kind()
-						return nil, buildMessage(*validator.CustomMsg.OnTypeNotMatch, MessageMeta{
+						return nil, buildMessage(CodeType, *validator.CustomMsg.OnTypeNotMatch, MessageMeta{
 							Field:        &field,
 							ExpectedType: &expectedType,
 							ActualType:   &dataType,
@@ -689,136 +832,15 @@ func validateValueInternal(data interface{}, validator Rules, dataFrom loadFromT
 				}
 			}
 
-			// Handle cross-type enum validation for integer family from HTTP JSON
-			if (dataFrom == fromHttpJson || dataFrom == fromJSONEncoder) &&
-				isIntegerFamily(enumType.Elem().Kind()) && isIntegerFamily(dataType) &&
-				dataType != enumType.Elem().Kind() {
-				// Convert float64 JSON data to compare with integer family enum items
-				if dataType == reflect.Float64 {
-					floatData := data.(float64)
-					enumKind := enumType.Elem().Kind()
-
-					// For float32 and float64, no integer conversion check needed
-					if enumKind == reflect.Float32 || enumKind == reflect.Float64 {
-						// Handle float enum types
-						switch enumKind {
-						case reflect.Float32:
-							var values []float32
-							for i := 0; i < enumValue.Len(); i++ {
-								values = append(values, float32(enumValue.Index(i).Float()))
-							}
-							if !valueInList[float32](values, float32(floatData), func(a, b float32) bool { return a == b }) {
-								return nil, buildEnumErrorMessage(values, enumType, dataType)
-							}
-						case reflect.Float64:
-							var values []float64
-							for i := 0; i < enumValue.Len(); i++ {
-								values = append(values, enumValue.Index(i).Float())
-							}
-							if !valueInList[float64](values, floatData, isEqualFloat64) {
-								return nil, buildEnumErrorMessage(values, enumType, dataType)
-							}
-						}
-						return data, nil
-					}
-
-					// Check if float64 can be safely converted to integer (no decimal part)
-					if floatData == float64(int64(floatData)) {
-						// Handle different integer enum types
-						switch enumType.Elem().Kind() {
-						case reflect.Int:
-							var values []int
-							for i := 0; i < enumValue.Len(); i++ {
-								values = append(values, int(enumValue.Index(i).Int()))
-							}
-							if !valueInList[int](values, int(floatData), isEqualInt) {
-								return nil, buildEnumErrorMessage(values, enumType, dataType)
-							}
-						case reflect.Int64:
-							var values []int64
-							for i := 0; i < enumValue.Len(); i++ {
-								values = append(values, enumValue.Index(i).Int())
-							}
-							if !valueInList[int64](values, int64(floatData), isEqualInt64) {
-								return nil, buildEnumErrorMessage(values, enumType, dataType)
-							}
-						case reflect.Int32:
-							var values []int32
-							for i := 0; i < enumValue.Len(); i++ {
-								values = append(values, int32(enumValue.Index(i).Int()))
-							}
-							if !valueInList[int32](values, int32(floatData), func(a, b int32) bool { return a == b }) {
-								return nil, buildEnumErrorMessage(values, enumType, dataType)
-							}
-						case reflect.Int16:
-							var values []int16
-							for i := 0; i < enumValue.Len(); i++ {
-								values = append(values, int16(enumValue.Index(i).Int()))
-							}
-							if !valueInList[int16](values, int16(floatData), func(a, b int16) bool { return a == b }) {
-								return nil, buildEnumErrorMessage(values, enumType, dataType)
-							}
-						case reflect.Int8:
-							var values []int8
-							for i := 0; i < enumValue.Len(); i++ {
-								values = append(values, int8(enumValue.Index(i).Int()))
-							}
-							if !valueInList[int8](values, int8(floatData), func(a, b int8) bool { return a == b }) {
-								return nil, buildEnumErrorMessage(values, enumType, dataType)
-							}
-						case reflect.Uint, reflect.Uint64, reflect.Uint32, reflect.Uint16, reflect.Uint8:
-							// Handle unsigned integers
-							var values []uint64
-							for i := 0; i < enumValue.Len(); i++ {
-								values = append(values, enumValue.Index(i).Uint())
-							}
-							if floatData < 0 || !valueInList[uint64](values, uint64(floatData), func(a, b uint64) bool { return a == b }) {
-								return nil, buildEnumErrorMessage(values, enumType, dataType)
-							}
-						}
-						return data, nil
-					} else {
-						// Float has decimal part, cannot convert to integer enum
-						return nil, buildErrorMessage(field, "should be '"+enumType.Elem().Kind().String()+"'")
-					}
-				}
+			item, found, supported := enumItem(validator.Enum, data)
+			if !supported {
+				return nil, buildErrorMessageKey(field, CodeEnum, MsgEnumUnsupported, MessageMeta{ActualType: &dataType})
 			}
-
-			switch dataType {
-			case reflect.Int:
-				var values []int
-				for i := 0; i < enumValue.Len(); i++ {
-					values = append(values, int(enumValue.Index(i).Int()))
-				}
-				if !valueInList[int](values, data.(int), isEqualInt) {
-					return nil, buildEnumErrorMessage(values, enumType, dataType)
-				}
-			case reflect.Int64:
-				var values []int64
-				for i := 0; i < enumValue.Len(); i++ {
-					values = append(values, enumValue.Index(i).Int())
-				}
-				if !valueInList[int64](values, data.(int64), isEqualInt64) {
-					return nil, buildEnumErrorMessage(values, enumType, dataType)
-				}
-			case reflect.Float64:
-				var values []float64
-				for i := 0; i < enumValue.Len(); i++ {
-					values = append(values, enumValue.Index(i).Float())
-				}
-				if !valueInList[float64](values, data.(float64), isEqualFloat64) {
-					return nil, buildEnumErrorMessage(values, enumType, dataType)
-				}
-			case reflect.String:
-				var values []string
-				for i := 0; i < enumValue.Len(); i++ {
-					values = append(values, enumValue.Index(i).String())
-				}
-				if !valueInList[string](values, data.(string), isEqualString) {
-					return nil, buildEnumErrorMessage(values, enumType, dataType)
-				}
-			default:
-				return nil, buildErrorMessagef(field, "enum is not supported for type '%s'", dataType.String())
+			if !found {
+				return nil, buildEnumErrorMessage(validator.Enum.Items, enumType, dataType)
+			}
+			if validator.Enum.Canonical {
+				data = item
 			}
 		}
 		return data, nil
@@ -829,7 +851,7 @@ func validateValueInternal(data interface{}, validator Rules, dataFrom loadFromT
 	}
 
 	if validator.UUID {
-		errMsg := buildErrorMessage(field, "is not valid uuid")
+		errMsg := buildRuleMessage(validator.CustomMsg.OnUUID, field, CodeUUID, actualValueMeta(data))
 		stringUuid, ok := data.(string)
 		if !ok {
 			return nil, errMsg
@@ -846,12 +868,12 @@ func validateValueInternal(data interface{}, validator Rules, dataFrom loadFromT
 
 	if validator.Email {
 		if reflect.TypeOf(data).Kind() != reflect.String || !isEmail(data.(string)) {
-			return nil, buildErrorMessage(field, "is not valid email")
+			return nil, buildRuleMessage(validator.CustomMsg.OnEmail, field, CodeEmail, actualValueMeta(data))
 		}
 	}
 
 	if validator.IPV4 {
-		errMsg := buildErrorMessage(field, "is not valid IP")
+		errMsg := buildRuleMessage(validator.CustomMsg.OnIPV4, field, CodeIPv4, actualValueMeta(data))
 		stringIp, ok := data.(string)
 		if !ok {
 			return nil, errMsg
@@ -863,7 +885,7 @@ func validateValueInternal(data interface{}, validator Rules, dataFrom loadFromT
 	}
 
 	if validator.IPV4Network {
-		errMsg := buildErrorMessage(field, "is not valid IP Network")
+		errMsg := buildRuleMessage(validator.CustomMsg.OnIPV4Network, field, CodeIPv4Network, actualValueMeta(data))
 		stringIp, ok := data.(string)
 		if !ok {
 			return nil, errMsg
@@ -875,7 +897,7 @@ func validateValueInternal(data interface{}, validator Rules, dataFrom loadFromT
 	}
 
 	if validator.IPv4OptionalPrefix {
-		errMsg := buildErrorMessage(field, "is not valid IP")
+		errMsg := buildRuleMessage(validator.CustomMsg.OnIPv4OptionalPrefix, field, CodeIPv4Prefix, actualValueMeta(data))
 		stringIp, ok := data.(string)
 		if !ok {
 			return nil, errMsg
@@ -914,7 +936,7 @@ func validateValueInternal(data interface{}, validator Rules, dataFrom loadFromT
 	if validator.AnonymousObject || validator.Object != nil {
 		res, err := toMapStringInterface(data)
 		if err != nil {
-			return nil, buildErrorMessage(field, "is not valid object")
+			return nil, buildRuleMessage(validator.CustomMsg.OnObject, field, CodeObject, MessageMeta{ActualType: &dataType})
 		}
 		return res, nil
 	}
@@ -926,22 +948,21 @@ func validateValueInternal(data interface{}, validator Rules, dataFrom loadFromT
 		}
 	}
 
+	if isIntegerFamily(dataType) {
+		if err := validateNumber(data, validator.Min, validator.Max, validator.NumberRules, validator.CustomMsg, field); err != nil {
+			return nil, err
+		}
+		return data, nil
+	}
+
 	if validator.Min != nil && data != nil {
 		var isErr bool
 		var actualLength int64
-		err := buildErrorMessagef(field, "should be or greater than %v", *validator.Min)
 		if reflect.String == dataType {
 			if total := utf8.RuneCountInString(data.(string)); int64(total) < *validator.Min {
 				isErr = true
 				actualLength = int64(total)
 			}
-		} else if isIntegerFamily(dataType) {
-			strData := removeAfter(fmt.Sprintf("%v", data), "e+")
-			num := extractInteger(strData)
-			if num < *validator.Min {
-				isErr = true
-				actualLength = num
-			}
 		} else if reflect.Slice == dataType {
 			total := int64(len(sliceData))
 			if total < *validator.Min {
@@ -951,33 +972,26 @@ func validateValueInternal(data interface{}, validator Rules, dataFrom loadFromT
 		}
 
 		if isErr {
+			meta := MessageMeta{
+				Field:             &field,
+				ExpectedMinLength: SetTotal(*validator.Min),
+				ActualLength:      SetTotal(actualLength),
+			}
 			if validator.CustomMsg.OnMin != nil {
-				return nil, buildMessage(*validator.CustomMsg.OnMin, MessageMeta{
-					Field:             &field,
-					ExpectedMinLength: SetTotal(*validator.Min),
-					ActualLength:      SetTotal(actualLength),
-				})
+				return nil, buildMessage(CodeMin, *validator.CustomMsg.OnMin, meta)
 			}
-			return nil, err
+			return nil, buildErrorMessage(field, CodeMin, meta)
 		}
 	}
 
 	if validator.Max != nil && data != nil {
 		var isErr bool
 		var actualLength int64
-		err := buildErrorMessagef(field, "should be or lower than %v", *validator.Max)
 		if reflect.String == dataType {
 			if total := utf8.RuneCountInString(data.(string)); int64(total) > *validator.Max {
 				isErr = true
 				actualLength = int64(total)
 			}
-		} else if isIntegerFamily(dataType) {
-			strData := removeAfter(fmt.Sprintf("%v", data), "e+")
-			num := extractInteger(strData)
-			if num > *validator.Max {
-				isErr = true
-				actualLength = num
-			}
 		} else if reflect.Slice == dataType {
 			total := int64(len(sliceData))
 			if total > *validator.Max {
@@ -987,20 +1001,108 @@ func validateValueInternal(data interface{}, validator Rules, dataFrom loadFromT
 		}
 
 		if isErr {
+			meta := MessageMeta{
+				Field:             &field,
+				ExpectedMaxLength: SetTotal(*validator.Max),
+				ActualLength:      SetTotal(actualLength),
+			}
 			if validator.CustomMsg.OnMax != nil {
-				return nil, buildMessage(*validator.CustomMsg.OnMax, MessageMeta{
-					Field:             &field,
-					ExpectedMaxLength: SetTotal(*validator.Max),
-					ActualLength:      SetTotal(actualLength),
-				})
+				return nil, buildMessage(CodeMax, *validator.CustomMsg.OnMax, meta)
 			}
-			return nil, err
+			return nil, buildErrorMessage(field, CodeMax, meta)
 		}
 	}
 
 	return data, nil
 }
 
+// validateListElement checks a single element of a primitive List rule.
+// elementMinPtr/elementMaxPtr come from the ListRules of the element rule.
+func validateListElement(it interface{}, validator Rules, originalElementKind reflect.Kind, elementMinPtr, elementMaxPtr *int64, dataFrom loadFromType, field string) (interface{}, error) {
+	tmpRule := validator
+	tmpRule.List = nil
+	tmpRule.ListObject = nil
+	tmpRule.Object = nil
+	// restore element type for per-item validation
+	tmpRule.Type = originalElementKind
+	// By default, do not carry container Min/Max into element checks
+	tmpRule.Min = nil
+	tmpRule.Max = nil
+	if num, ok := it.(json.Number); ok {
+		var err error
+		if it, err = normalizeJSONNumber(num, tmpRule, field); err != nil {
+			return nil, err
+		}
+	}
+	// Apply element content constraints (pre-check) for string and numeric elements
+	if it != nil {
+		gotKind := reflect.TypeOf(it).Kind()
+		// Resolve effective element kind: explicit Type if provided, else infer from value
+		effectiveKind := originalElementKind
+		if effectiveKind == reflect.Invalid {
+			effectiveKind = gotKind
+		}
+		// String length constraints
+		if effectiveKind == reflect.String && gotKind == reflect.String {
+			if elementMinPtr != nil {
+				actualLen := int64(utf8.RuneCountInString(it.(string)))
+				if actualLen < *elementMinPtr {
+					minMeta := MessageMeta{
+						Field:             &field,
+						ExpectedMinLength: elementMinPtr,
+						ActualLength:      &actualLen,
+					}
+					if validator.CustomMsg.OnMin != nil {
+						return nil, buildMessage(CodeMin, *validator.CustomMsg.OnMin, minMeta)
+					}
+					return nil, buildErrorMessage(field, CodeMin, minMeta)
+				}
+			}
+			if elementMaxPtr != nil {
+				actualLen := int64(utf8.RuneCountInString(it.(string)))
+				if actualLen > *elementMaxPtr {
+					maxMeta := MessageMeta{
+						Field:             &field,
+						ExpectedMaxLength: elementMaxPtr,
+						ActualLength:      &actualLen,
+					}
+					if validator.CustomMsg.OnMax != nil {
+						return nil, buildMessage(CodeMax, *validator.CustomMsg.OnMax, maxMeta)
+					}
+					return nil, buildErrorMessage(field, CodeMax, maxMeta)
+				}
+			}
+		}
+		// Numeric value constraints
+		if isIntegerFamily(effectiveKind) && isIntegerFamily(gotKind) {
+			if err := validateNumber(it, elementMinPtr, elementMaxPtr, nil, validator.CustomMsg, field); err != nil {
+				return nil, err
+			}
+		}
+	}
+	// Pre-check element type mismatch to craft a clearer wording
+	// Only when element Type is explicitly set (avoid interfering with Enum/UUID/Regex-only rules)
+	if it != nil && tmpRule.Type != reflect.Invalid {
+		gotKind := reflect.TypeOf(it).Kind()
+		expectedKind := tmpRule.Type
+		allowIntCoerce := isJSONLike(dataFrom) && isIntegerFamily(expectedKind) && isIntegerFamily(gotKind)
+		if gotKind != expectedKind && !allowIntCoerce {
+			// Map kind to human-friendly noun (e.g., int/uint/float -> integer)
+			key := MsgTypeElement
+			if isIntegerFamily(expectedKind) {
+				key = MsgTypeElementInteger
+			}
+			return nil, buildErrorMessageKey(field, CodeType, key, MessageMeta{
+				ExpectedType: &expectedKind,
+				ActualType:   &gotKind,
+			})
+		}
+	}
+
+	// Recursive validation for each element
+	return validateValueInternal(it, tmpRule, dataFrom, field)
+}
+
 func SetTotal(total int64) *int64 {
 	return &total
 }
@@ -1028,6 +1130,9 @@ func toInterfaceSlice(slice interface{}) ([]interface{}, bool) {
 }
 
 func toMapStringInterface(data interface{}) (map[string]interface{}, error) {
+	if m, ok := data.(map[string]interface{}); ok {
+		return m, nil
+	}
 	m, ioData := data, new(bytes.Buffer)
 	var res map[string]interface{}
 	err := json.NewEncoder(ioData).Encode(&m)
@@ -1041,28 +1146,6 @@ func toMapStringInterface(data interface{}) (map[string]interface{}, error) {
 	return res, nil
 }
 
-func removeAfter(data, after string) string {
-	split := strings.Split(data, after)
-	if len(split) > 0 {
-		return split[0]
-	}
-	return data
-}
-
-func extractInteger(data string) int64 {
-	var intStr string
-	re := regexp.MustCompile("[0-9]+")
-	resStr := re.FindAllString(data, -1)
-	for _, val := range resStr {
-		intStr += val
-	}
-	res, err := strconv.ParseInt(intStr, 10, 64)
-	if err != nil {
-		return 0
-	}
-	return res
-}
-
 func convertValue(newValue interface{}, kind reflect.Kind, data reflect.Value, pointer bool) error {
 	errNotSupport := errors.New("not support data")
 	switch kind {
@@ -1241,6 +1324,17 @@ func getAllKeys(data map[string]interface{}) (allKeysInMap []string) {
 	return
 }
 
+// sortedKeys returns the keys of m in a stable order so that validation walks
+// fields (and reports failures) deterministically.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
 func isDataInList[T validatorType](key T, data []T) (result bool) {
 	for _, val := range data {
 		if val == key {
@@ -1250,6 +1344,12 @@ func isDataInList[T validatorType](key T, data []T) (result bool) {
 	return
 }
 
+// isJSONLike reports whether values from dataFrom may hold JSON-decoded
+// numbers (float64 for every integer kind).
+func isJSONLike(dataFrom loadFromType) bool {
+	return dataFrom == fromHttpJson || dataFrom == fromJSONEncoder || dataFrom == fromHttpRequest
+}
+
 func isIntegerFamily(dataType reflect.Kind) bool {
 	switch dataType {
 	case reflect.Int, reflect.Int8, reflect.Int16,
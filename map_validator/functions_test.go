@@ -265,10 +265,10 @@ func TestIntFamily(t *testing.T) {
 		t.Errorf("Test case 2 Error : %v", err)
 	}
 
-	payload = map[string]interface{}{"power": 133.3, "harga": 1.3}
+	payload = map[string]interface{}{"power": 133.0, "harga": 1.3}
 	_, err = validate(
 		"power", payload, Rules{
-			Type: reflect.Int,
+			Type: reflect.Int16,
 		}, fromHttpJson,
 	)
 	if err != nil {
@@ -276,13 +276,22 @@ func TestIntFamily(t *testing.T) {
 	}
 
 	payload = map[string]interface{}{"power": 133.3, "harga": 1.3}
+	_, err = validate(
+		"power", payload, Rules{
+			Type: reflect.Int,
+		}, fromHttpJson,
+	)
+	if err == nil || err.Error() != "the field 'power' should be 'int'" {
+		t.Errorf("Expected fraction to be rejected, but got %v", err)
+	}
+
 	_, err = validate(
 		"power", payload, Rules{
 			Type: reflect.Int16,
 		}, fromHttpJson,
 	)
-	if err != nil {
-		t.Errorf("Test case 1 Error : %v", err)
+	if err == nil || err.Error() != "the field 'power' should be 'int16'" {
+		t.Errorf("Expected fraction to be rejected, but got %v", err)
 	}
 
 	payload = map[string]interface{}{"power": "133.3", "harga": 1.3}
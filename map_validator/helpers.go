@@ -36,3 +36,28 @@ func ValidateJSON[T any](r *http.Request, rules RulesWrapper) (T, error) {
 	}
 	return out, nil
 }
+
+// ValidateQuery is the ValidateJSON counterpart for URL query parameters: it
+// runs LoadQueryHttp → RunValidate → Bind and returns the bound value.
+//
+//	type ListUsers struct {
+//		Page   int      `json:"page"`
+//		Status []string `json:"status"`
+//	}
+//	q, err := map_validator.ValidateQuery[ListUsers](r, rules)
+func ValidateQuery[T any](r *http.Request, rules RulesWrapper) (T, error) {
+	var zero T
+	op, err := NewValidateBuilder().SetRules(rules).LoadQueryHttp(r)
+	if err != nil {
+		return zero, err
+	}
+	extra, err := op.RunValidate()
+	if err != nil {
+		return zero, err
+	}
+	var out T
+	if err := extra.Bind(&out); err != nil {
+		return zero, err
+	}
+	return out, nil
+}
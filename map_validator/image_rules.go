@@ -0,0 +1,105 @@
+package map_validator
+
+import (
+	"fmt"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// ImageInfo is what an Image rule read from the file header.
+type ImageInfo struct {
+	Format string // "png", "jpeg" or "gif"
+	Width  int
+	Height int
+}
+
+// ImageRules check an uploaded image. Only the header is decoded
+// (image.DecodeConfig), never the pixels. Zero values mean no limit.
+//
+// Template variables for the CustomMsg image hooks:
+//   - ${image_format} / ${allowed_types}: decoded format and Formats (OnImageFormat)
+//   - ${image_width} / ${image_height}: decoded size (OnImageDimension, OnImageAspectRatio)
+//   - ${aspect_ratio} / ${expected_aspect_ratio}: width/height ratios (OnImageAspectRatio)
+type ImageRules struct {
+	// Formats limits the decoded format: "png", "jpeg" (or "jpg"), "gif".
+	Formats   []string
+	MinWidth  int
+	MaxWidth  int
+	MinHeight int
+	MaxHeight int
+	// AspectRatio is width / height, e.g. 16.0 / 9. AspectTolerance is the
+	// allowed absolute difference and defaults to 0.01.
+	AspectRatio     float64
+	AspectTolerance float64
+}
+
+// Image is a File rule that must hold an image matching rules. The checked
+// FileRequest carries the decoded ImageInfo.
+//
+//	SetRule("avatar", Image(ImageRules{Formats: []string{"png", "jpeg"}, MaxWidth: 1024, AspectRatio: 1}).MaxSize(2 << 20))
+func Image(rules ImageRules) Rules {
+	return File().WithImage(rules)
+}
+
+func validateImage(file FileRequest, rules *ImageRules, custom CustomMsg, field string) (FileRequest, error) {
+	if file.File == nil || file.FileInfo == nil {
+		return file, buildRuleMessage(custom.OnImage, field, CodeImage, MessageMeta{})
+	}
+	config, format, err := image.DecodeConfig(io.NewSectionReader(file.File, 0, file.FileInfo.Size))
+	if err != nil {
+		return file, buildRuleMessage(custom.OnImage, field, CodeImage, MessageMeta{})
+	}
+	if len(rules.Formats) > 0 && !imageFormatAllowed(format, rules.Formats) {
+		allowed := fmt.Sprintf("%v", rules.Formats)
+		return file, buildRuleMessage(custom.OnImageFormat, field, CodeImageFormat, MessageMeta{
+			ImageFormat:  &format,
+			AllowedTypes: &allowed,
+		})
+	}
+	width, height := int64(config.Width), int64(config.Height)
+	sizeMeta := MessageMeta{ImageWidth: &width, ImageHeight: &height}
+	if rules.MinWidth > 0 && config.Width < rules.MinWidth ||
+		rules.MaxWidth > 0 && config.Width > rules.MaxWidth ||
+		rules.MinHeight > 0 && config.Height < rules.MinHeight ||
+		rules.MaxHeight > 0 && config.Height > rules.MaxHeight {
+		return file, buildRuleMessage(custom.OnImageDimension, field, CodeImageDimension, sizeMeta)
+	}
+	if rules.AspectRatio > 0 {
+		tolerance := rules.AspectTolerance
+		if tolerance <= 0 {
+			tolerance = 0.01
+		}
+		ratio := 0.0
+		if config.Height > 0 {
+			ratio = float64(config.Width) / float64(config.Height)
+		}
+		if math.Abs(ratio-rules.AspectRatio) > tolerance {
+			actual := strconv.FormatFloat(ratio, 'f', 2, 64)
+			expected := strconv.FormatFloat(rules.AspectRatio, 'f', 2, 64)
+			sizeMeta.AspectRatio = &actual
+			sizeMeta.ExpectedRatio = &expected
+			return file, buildRuleMessage(custom.OnImageAspectRatio, field, CodeImageAspectRatio, sizeMeta)
+		}
+	}
+	file.Image = &ImageInfo{Format: format, Width: config.Width, Height: config.Height}
+	return file, nil
+}
+
+func imageFormatAllowed(format string, formats []string) bool {
+	for _, item := range formats {
+		item = strings.ToLower(item)
+		if item == "jpg" {
+			item = "jpeg"
+		}
+		if item == format {
+			return true
+		}
+	}
+	return false
+}
@@ -3,8 +3,11 @@ package map_validator
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
+	"io"
 	"net/http"
-	"reflect"
+	"net/url"
+	"strings"
 )
 
 func NewValidateBuilder() *ruleState {
@@ -28,6 +31,11 @@ func (state *ruleState) SetRules(validations RulesWrapper) *dataState {
 		rules:              state.rules,
 		extension:          state.extension,
 		strictAllowedValue: state.strictAllowedValue,
+		locale:             state.locale,
+		acceptLanguage:     state.acceptLanguage,
+		pathExtractor:      state.pathExtractor,
+		jsonLimits:         state.jsonLimits,
+		useNumber:          state.useNumber,
 	}
 }
 
@@ -36,6 +44,59 @@ func (state *ruleState) AddExtension(extension ExtensionType) *ruleState {
 	return state
 }
 
+// SetLocale picks the message catalog used for default error messages
+func (state *ruleState) SetLocale(locale string) *ruleState {
+	state.locale = locale
+	return state
+}
+
+// UseAcceptLanguage makes the HTTP loaders pick the message catalog from the
+// request's Accept-Language header, falling back to the SetLocale value.
+func (state *ruleState) UseAcceptLanguage() *ruleState {
+	state.acceptLanguage = true
+	return state
+}
+
+// SetPathParamExtractor sets how LoadPathHttp reads path parameters. The
+// default is DefaultPathParamExtractor (net/http ServeMux patterns).
+func (state *ruleState) SetPathParamExtractor(extractor PathParamExtractor) *ruleState {
+	state.pathExtractor = extractor
+	return state
+}
+
+// SetJSONLimits caps the request body read by the JSON loaders, overriding
+// Setting.JSONLimits of the rules.
+func (state *ruleState) SetJSONLimits(limits JSONLimits) *ruleState {
+	state.jsonLimits = &limits
+	return state
+}
+
+// UseNumber makes the JSON loaders keep numbers as json.Number until they are
+// checked against the rule's kind, so large int64 / uint64 values keep
+// every digit. Same as Setting.UseNumber.
+func (state *ruleState) UseNumber() *ruleState {
+	state.useNumber = true
+	return state
+}
+
+// limits returns the JSONLimits that apply to this load, if any
+func (state *dataState) limits() *JSONLimits {
+	if state.jsonLimits != nil {
+		return state.jsonLimits
+	}
+	return state.rules.getSetting().JSONLimits
+}
+
+// requestLocale returns the locale for a run loaded from r
+func (state *dataState) requestLocale(r *http.Request) string {
+	if state.acceptLanguage {
+		if locale := LocaleFromRequest(r); locale != "" {
+			return locale
+		}
+	}
+	return state.locale
+}
+
 //	func (state *dataState) checkStrictKeys(data map[string]interface{}) error {
 //		var allowedKeys []string
 //		keys := getAllKeys(data)
@@ -75,6 +136,7 @@ func (state *dataState) Load(data map[string]interface{}) (*finalOperation, erro
 		loadedFrom: fromMapString,
 		extension:  state.extension,
 		data:       data,
+		locale:     state.locale,
 	}, nil
 }
 
@@ -94,13 +156,9 @@ func (state *dataState) LoadJsonHttp(r *http.Request) (*finalOperation, error) {
 			return nil, err
 		}
 	}
-	var mapData map[string]interface{}
-	err := json.NewDecoder(r.Body).Decode(&mapData)
+	mapData, err := decodeJSONBody(r, state.limits(), state.useNumber || state.rules.getSetting().UseNumber)
 	if err != nil {
-		if err.Error() != "EOF" {
-			return nil, ErrInvalidJsonFormat
-		}
-		mapData = make(map[string]interface{})
+		return nil, err
 	}
 	//if state.strictAllowedValue {
 	//	if err := state.checkStrictKeys(mapData); err != nil {
@@ -118,6 +176,7 @@ func (state *dataState) LoadJsonHttp(r *http.Request) (*finalOperation, error) {
 		loadedFrom: fromHttpJson,
 		extension:  state.extension,
 		data:       mapData,
+		locale:     state.requestLocale(r),
 	}, nil
 }
 
@@ -137,11 +196,25 @@ func (state *dataState) LoadFormHttp(r *http.Request) (*finalOperation, error) {
 			return nil, err
 		}
 	}
+	if r.Form == nil {
+		_ = r.ParseMultipartForm(defaultMaxFormMemory)
+	}
+	formData := decodeFormValues(r.Form)
 	mapData := map[string]interface{}{}
-	allowType := []reflect.Kind{reflect.String, reflect.Int, reflect.Bool}
-	for key, rule := range state.rules.getRules() {
-		var isAllowType bool
-		if rule.File {
+	var indexErrs ValidationErrors
+	rules := state.rules.getRules()
+	for _, key := range sortedKeys(rules) {
+		rule := rules[key]
+		if rule.File && rule.List != nil {
+			mapData[key] = nil
+			if r.MultipartForm != nil && len(r.MultipartForm.File[key]) > 0 {
+				files, err := fileRequests(r.MultipartForm.File[key])
+				if err != nil {
+					return nil, err
+				}
+				mapData[key] = files
+			}
+		} else if rule.File {
 			file, fileInfo, err := r.FormFile(key)
 			if err != nil {
 				mapData[key] = nil
@@ -152,23 +225,23 @@ func (state *dataState) LoadFormHttp(r *http.Request) (*finalOperation, error) {
 				mapData[key] = FileRequest{File: file, FileInfo: fileInfo}
 			}
 		} else {
-			for _, allowItem := range allowType {
-				if rule.Type == allowItem {
-					isAllowType = true
-					break
-				}
-			}
-			if !isAllowType {
+			if !isCoercibleRule(rule) && rule.Object == nil && rule.ListObject == nil && !rule.AnonymousObject {
 				return nil, ErrUnsupportType
 			}
-			value := r.FormValue(key)
-			if value == "" {
-				mapData[key] = nil
-			} else {
-				mapData[key] = value
+			value, err := formListify(formData[key], key, fieldPath{}.child(key))
+			if err != nil {
+				indexErrs = append(indexErrs, err)
+				continue
 			}
+			mapData[key] = coerceFormValue(value, rule)
 		}
 	}
+	locale := state.requestLocale(r)
+	if len(indexErrs) == 1 {
+		return nil, LocalizeError(indexErrs[0], locale)
+	} else if len(indexErrs) > 1 {
+		return nil, LocalizeError(indexErrs, locale)
+	}
 	//if state.strictAllowedValue {
 	//	if err := state.checkStrictKeys(mapData); err != nil {
 	//		return nil, err
@@ -185,10 +258,362 @@ func (state *dataState) LoadFormHttp(r *http.Request) (*finalOperation, error) {
 		loadedFrom: fromHttpMultipartForm,
 		extension:  state.extension,
 		data:       mapData,
+		locale:     locale,
+	}, nil
+}
+
+// LoadQueryHttp loads the URL query parameters of r. Values are parsed into
+// the rule's kind like LoadFormHttp does; a key repeated in the query
+// (?status=a&status=b) becomes a list for List rules. Keys without a rule
+// are kept as raw strings so Strict mode can reject them.
+func (state *dataState) LoadQueryHttp(r *http.Request) (*finalOperation, error) {
+	if state == nil {
+		return nil, errors.New("no data to Load because last progress is error")
+	}
+	if state.rules == nil || len(state.rules.getRules()) == 0 {
+		return nil, ErrNoRules
+	}
+	if r == nil || r.URL == nil {
+		return nil, errors.New("no data to Load")
+	}
+	for _, ex := range state.extension {
+		err := ex.BeforeLoad(r)
+		if err != nil {
+			return nil, err
+		}
+	}
+	query := r.URL.Query()
+	rules := state.rules.getRules()
+	mapData := map[string]interface{}{}
+	for key, values := range query {
+		if _, ok := rules[key]; !ok && len(values) > 0 {
+			mapData[key] = values[0]
+		}
+	}
+	for key, rule := range rules {
+		if !isCoercibleRule(rule) || rule.File {
+			return nil, ErrUnsupportType
+		}
+		mapData[key] = coerceValues(query[key], rule)
+	}
+	for _, ex := range state.extension {
+		err := ex.AfterLoad(&mapData)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &finalOperation{
+		rules:      state.rules,
+		loadedFrom: fromHttpQuery,
+		extension:  state.extension,
+		data:       mapData,
+		locale:     state.requestLocale(r),
+	}, nil
+}
+
+// LoadPathHttp loads the path parameters of r named after the rule keys,
+// e.g. {id} for SetRule("id", UUID()). Values are parsed into the rule's
+// kind like query parameters. Parameters are read with the extractor set by
+// SetPathParamExtractor, or DefaultPathParamExtractor.
+func (state *dataState) LoadPathHttp(r *http.Request) (*finalOperation, error) {
+	if state == nil {
+		return nil, errors.New("no data to Load because last progress is error")
+	}
+	if state.rules == nil || len(state.rules.getRules()) == 0 {
+		return nil, ErrNoRules
+	}
+	if r == nil {
+		return nil, errors.New("no data to Load")
+	}
+	for _, ex := range state.extension {
+		err := ex.BeforeLoad(r)
+		if err != nil {
+			return nil, err
+		}
+	}
+	mapData := map[string]interface{}{}
+	for key, rule := range state.rules.getRules() {
+		if !isCoercibleRule(rule) || rule.File || rule.List != nil {
+			return nil, ErrUnsupportType
+		}
+		mapData[key] = state.pathValue(r, key, rule)
+	}
+	for _, ex := range state.extension {
+		err := ex.AfterLoad(&mapData)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &finalOperation{
+		rules:      state.rules,
+		loadedFrom: fromHttpPath,
+		extension:  state.extension,
+		data:       mapData,
+		locale:     state.requestLocale(r),
+	}, nil
+}
+
+// LoadRequestHttp merges every part of r into one validation run. Each
+// top-level rule reads from the source it declares with FromQuery, FromPath
+// or FromHeader; the others come from the JSON body. A value sent in the body
+// for a rule that reads from another source is reported as a
+// CodeSourceConflict error.
+func (state *dataState) LoadRequestHttp(r *http.Request) (*finalOperation, error) {
+	if state == nil {
+		return nil, errors.New("no data to Load because last progress is error")
+	}
+	if state.rules == nil || len(state.rules.getRules()) == 0 {
+		return nil, ErrNoRules
+	}
+	if r == nil {
+		return nil, errors.New("no data to Load")
+	}
+	for _, ex := range state.extension {
+		err := ex.BeforeLoad(r)
+		if err != nil {
+			return nil, err
+		}
+	}
+	mapData, err := decodeJSONBody(r, state.limits(), state.useNumber || state.rules.getSetting().UseNumber)
+	if err != nil {
+		return nil, err
+	}
+	if mapData == nil {
+		mapData = make(map[string]interface{})
+	}
+	var query url.Values
+	if r.URL != nil {
+		query = r.URL.Query()
+	}
+	rules := state.rules.getRules()
+	var conflicts ValidationErrors
+	for _, key := range sortedKeys(rules) {
+		rule := rules[key]
+		if rule.Source == SourceBody {
+			continue
+		}
+		if _, ok := mapData[key]; ok {
+			source := rule.Source.String()
+			conflicts = append(conflicts, buildErrorMessage(key, CodeSourceConflict, MessageMeta{Source: &source}))
+			continue
+		}
+		if !isCoercibleRule(rule) || rule.File || (rule.Source == SourcePath && rule.List != nil) {
+			return nil, ErrUnsupportType
+		}
+		switch rule.Source {
+		case SourceQuery:
+			mapData[key] = coerceValues(query[key], rule)
+		case SourcePath:
+			mapData[key] = state.pathValue(r, key, rule)
+		case SourceHeader:
+			mapData[key] = coerceValues(headerValues(r.Header, key, rule), rule)
+		}
+	}
+	locale := state.requestLocale(r)
+	if len(conflicts) == 1 {
+		return nil, LocalizeError(conflicts[0], locale)
+	} else if len(conflicts) > 1 {
+		return nil, LocalizeError(conflicts, locale)
+	}
+	for _, ex := range state.extension {
+		err := ex.AfterLoad(&mapData)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &finalOperation{
+		rules:      state.rules,
+		loadedFrom: fromHttpRequest,
+		extension:  state.extension,
+		data:       mapData,
+		locale:     locale,
+	}, nil
+}
+
+// LoadHeaderHttp loads request headers. Rule keys are header names and are
+// matched case-insensitively; use FromHeader(name) when the key should
+// differ from the header. Values are coerced like query parameters, and a
+// List rule gets every value of a repeated or comma-separated header.
+// Headers without a rule are not loaded.
+func (state *dataState) LoadHeaderHttp(r *http.Request) (*finalOperation, error) {
+	if state == nil {
+		return nil, errors.New("no data to Load because last progress is error")
+	}
+	if state.rules == nil || len(state.rules.getRules()) == 0 {
+		return nil, ErrNoRules
+	}
+	if r == nil {
+		return nil, errors.New("no data to Load")
+	}
+	for _, ex := range state.extension {
+		err := ex.BeforeLoad(r)
+		if err != nil {
+			return nil, err
+		}
+	}
+	mapData := map[string]interface{}{}
+	for key, rule := range state.rules.getRules() {
+		if !isCoercibleRule(rule) || rule.File {
+			return nil, ErrUnsupportType
+		}
+		mapData[key] = coerceValues(headerValues(r.Header, key, rule), rule)
+	}
+	for _, ex := range state.extension {
+		err := ex.AfterLoad(&mapData)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &finalOperation{
+		rules:      state.rules,
+		loadedFrom: fromHttpHeader,
+		extension:  state.extension,
+		data:       mapData,
+		locale:     state.requestLocale(r),
 	}, nil
 }
 
+// headerValues returns the values of the header read by rule. For List
+// rules comma-separated values are split into separate items.
+func headerValues(header http.Header, key string, rule Rules) []string {
+	name := key
+	if rule.SourceName != "" {
+		name = rule.SourceName
+	}
+	values := header.Values(name)
+	if rule.List == nil {
+		return values
+	}
+	var res []string
+	for _, value := range values {
+		for _, item := range strings.Split(value, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				res = append(res, item)
+			}
+		}
+	}
+	return res
+}
+
+// headerName returns the header read by the top-level rule key, or "" when
+// the rule is not loaded from a header.
+func (state *finalOperation) headerName(key string, rule Rules) string {
+	if state.loadedFrom != fromHttpHeader && (state.loadedFrom != fromHttpRequest || rule.Source != SourceHeader) {
+		return ""
+	}
+	if rule.SourceName != "" {
+		return rule.SourceName
+	}
+	return key
+}
+
+// nameHeaders makes the failures of header rules name the header instead
+// of the rule key, with "header" as ${source}. Paths keep the rule key.
+func (state *finalOperation) nameHeaders(err error) error {
+	switch e := err.(type) {
+	case ValidationErrors:
+		for i := range e {
+			e[i] = state.nameHeaders(e[i])
+		}
+	case *FieldError:
+		key := strings.SplitN(e.rel.dotted, "[", 2)[0]
+		rule, ok := state.rules.getRules()[key]
+		name := state.headerName(key, rule)
+		if !ok || name == "" || e.Field != key {
+			return err
+		}
+		e.Field = name
+		e.Params["field"] = name
+		e.Params["source"] = SourceHeader.String()
+		e.render()
+	}
+	return err
+}
+
+// pathValue reads the path parameter key with the configured extractor
+func (state *dataState) pathValue(r *http.Request, key string, rule Rules) interface{} {
+	extractor := state.pathExtractor
+	if extractor == nil {
+		extractor = DefaultPathParamExtractor
+	}
+	value, ok := extractor.PathParam(r, key)
+	if !ok || value == "" {
+		return nil
+	}
+	return coerceString(value, rule)
+}
+
+// decodeJSONBody decodes the JSON object in r's body; an empty body gives
+// an empty map.
+func decodeJSONBody(r *http.Request, limits *JSONLimits, useNumber bool) (map[string]interface{}, error) {
+	var mapData map[string]interface{}
+	if r.Body == nil {
+		return make(map[string]interface{}), nil
+	}
+	body := r.Body
+	if limits != nil && limits.MaxBodyBytes > 0 {
+		body = http.MaxBytesReader(nil, r.Body, limits.MaxBodyBytes)
+	}
+	decoder := json.NewDecoder(body)
+	if useNumber {
+		decoder.UseNumber()
+	}
+	var err error
+	if limits.bounded() {
+		mapData, err = limits.decodeObject(decoder)
+	} else {
+		err = decoder.Decode(&mapData)
+	}
+	if err != nil {
+		if errors.Is(err, ErrLimitExceeded) {
+			return nil, err
+		}
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			return nil, fmt.Errorf("%w: body larger than %d bytes", ErrLimitExceeded, maxBytesErr.Limit)
+		}
+		if err != io.EOF {
+			return nil, ErrInvalidJsonFormat
+		}
+		mapData = make(map[string]interface{})
+	}
+	return mapData, nil
+}
+
+// RunValidate validates the loaded data and returns the first failure. When
+// the top-level rules have Setting.AllErrors enabled it behaves like
+// RunValidateAll instead.
 func (state *finalOperation) RunValidate() (*ExtraOperationData, error) {
+	if state == nil || state.rules == nil {
+		return nil, errors.New("no data to Validate because last progress is error")
+	}
+	res, err := state.runValidate(state.rules.getSetting().AllErrors)
+	return res, state.localize(err)
+}
+
+// RunValidateAll walks every rule at every nesting level, keeps going after
+// failures and returns all of them as a single ValidationErrors value.
+func (state *finalOperation) RunValidateAll() (*ExtraOperationData, error) {
+	res, err := state.runValidate(true)
+	return res, state.localize(err)
+}
+
+// SetLocale overrides the message catalog for this run
+func (state *finalOperation) SetLocale(locale string) *finalOperation {
+	if state != nil {
+		state.locale = locale
+	}
+	return state
+}
+
+func (state *finalOperation) localize(err error) error {
+	if err == nil || state == nil || state.locale == "" {
+		return err
+	}
+	return LocalizeError(err, state.locale)
+}
+
+func (state *finalOperation) runValidate(collectErrors bool) (*ExtraOperationData, error) {
 	initChain := newChainer().SetKey(chainKey)
 	if state == nil || state.data == nil {
 		return nil, errors.New("no data to Validate because last progress is error")
@@ -202,10 +627,12 @@ func (state *finalOperation) RunValidate() (*ExtraOperationData, error) {
 		}
 	}
 	topState := newWrapperRunState()
-	for key, rule := range state.rules.getRules() {
-		data, err := validateRecursive(initChain, state.rules, topState, key, state.data, rule, state.loadedFrom)
+	topState.collectErrors = collectErrors
+	rules := state.rules.getRules()
+	for _, key := range sortedKeys(rules) {
+		data, err := validateRecursive(initChain, state.rules, topState, key, state.data, rules[key], state.loadedFrom)
 		if err != nil {
-			return nil, err
+			return nil, state.nameHeaders(err)
 		}
 		if data != nil {
 			filledFields = append(filledFields, key)
@@ -221,10 +648,21 @@ func (state *finalOperation) RunValidate() (*ExtraOperationData, error) {
 	}
 
 	chainRes.RunUniqueChecker()
+	if c, ok := chainRes.(compareChainer); ok {
+		c.runCompareChecker()
+	}
+	var validationErrs ValidationErrors
 	for _, err = range chainRes.GetErrors() {
-		if err != nil {
-			return nil, err
+		if err == nil {
+			continue
+		}
+		if !collectErrors {
+			return nil, state.nameHeaders(err)
 		}
+		validationErrs = append(validationErrs, err)
+	}
+	if len(validationErrs) > 0 {
+		return nil, state.nameHeaders(validationErrs)
 	}
 
 	manipulatedData := chainRes.ToMap()
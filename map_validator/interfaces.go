@@ -9,16 +9,27 @@ type validatorType interface {
 type setRoleOperationType interface {
 	SetRules(validations RulesWrapper) *dataState
 	AddExtension(extension ExtensionType) *ruleState
+	SetLocale(locale string) *ruleState
+	UseAcceptLanguage() *ruleState
+	SetPathParamExtractor(extractor PathParamExtractor) *ruleState
+	SetJSONLimits(limits JSONLimits) *ruleState
+	UseNumber() *ruleState
 }
 
 type loadOperationType interface {
 	LoadJsonHttp(r *http.Request) (*finalOperation, error)
 	LoadFormHttp(r *http.Request) (*finalOperation, error)
+	LoadQueryHttp(r *http.Request) (*finalOperation, error)
+	LoadPathHttp(r *http.Request) (*finalOperation, error)
+	LoadRequestHttp(r *http.Request) (*finalOperation, error)
+	LoadHeaderHttp(r *http.Request) (*finalOperation, error)
 	Load(data map[string]interface{}) (*finalOperation, error)
 }
 
 type finalOperationType interface {
 	RunValidate() (*ExtraOperationData, error)
+	RunValidateAll() (*ExtraOperationData, error)
+	SetLocale(locale string) *finalOperation
 }
 
 type ExtraOperationType interface {
@@ -71,6 +82,14 @@ type ChainerType interface {
 	GetBrothers() []ChainerType
 }
 
+// compareChainer holds the sibling comparisons of the chain tree. It is not
+// part of ChainerType / ChainResultType so their other implementations keep
+// compiling.
+type compareChainer interface {
+	setCompares(compares []FieldCompare)
+	runCompareChecker()
+}
+
 // RulesWrapper defines public methods for rulesWrapper
 type RulesWrapper interface {
 	getRules() map[string]Rules
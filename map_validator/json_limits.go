@@ -0,0 +1,136 @@
+package map_validator
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"unicode/utf8"
+)
+
+// ErrLimitExceeded is returned (wrapped, use errors.Is) by the JSON loaders
+// when the request body breaks one of the JSONLimits.
+var ErrLimitExceeded = errors.New("request body exceeds limits")
+
+// JSONLimits cap what LoadJsonHttp and LoadRequestHttp accept from the
+// request body. Zero values mean no limit.
+//
+// MaxBodyBytes is enforced while reading (http.MaxBytesReader), so an
+// oversized body is never read in full. When any of the other limits is
+// set the body is decoded token by token and rejected at the first token
+// that breaks one, before the rest of the body is read. A single string is
+// still read whole before MaxStringLength applies, so set MaxBodyBytes too
+// for a hard memory bound.
+type JSONLimits struct {
+	MaxBodyBytes    int64
+	MaxDepth        int
+	MaxKeys         int
+	MaxArrayLength  int
+	MaxStringLength int
+}
+
+// bounded reports whether the body has to be decoded token by token
+func (l *JSONLimits) bounded() bool {
+	return l != nil && (l.MaxDepth > 0 || l.MaxKeys > 0 || l.MaxArrayLength > 0 || l.MaxStringLength > 0)
+}
+
+// decodeObject decodes the top-level JSON value of decoder, which must be an
+// object or null, checking the limits as the tokens are read.
+func (l *JSONLimits) decodeObject(decoder *json.Decoder) (map[string]interface{}, error) {
+	value, err := l.decode(decoder, 1)
+	if err != nil || value == nil {
+		return nil, err
+	}
+	object, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, ErrInvalidJsonFormat
+	}
+	return object, nil
+}
+
+// decode reads the next JSON value at depth, building the same values as
+// json.Decoder.Decode into an interface{}.
+func (l *JSONLimits) decode(decoder *json.Decoder, depth int) (interface{}, error) {
+	token, err := decoder.Token()
+	if err != nil {
+		if depth > 1 {
+			return nil, truncated(err)
+		}
+		return nil, err
+	}
+	switch t := token.(type) {
+	case json.Delim:
+		if l.MaxDepth > 0 && depth > l.MaxDepth {
+			return nil, fmt.Errorf("%w: nesting deeper than %d", ErrLimitExceeded, l.MaxDepth)
+		}
+		if t == '[' {
+			return l.decodeArray(decoder, depth)
+		}
+		return l.decodeMap(decoder, depth)
+	case string:
+		if err := l.checkString(t); err != nil {
+			return nil, err
+		}
+	}
+	return token, nil
+}
+
+func (l *JSONLimits) decodeMap(decoder *json.Decoder, depth int) (interface{}, error) {
+	object := map[string]interface{}{}
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, truncated(err)
+		}
+		key, _ := token.(string)
+		if err := l.checkString(key); err != nil {
+			return nil, err
+		}
+		if _, seen := object[key]; !seen && l.MaxKeys > 0 && len(object) >= l.MaxKeys {
+			return nil, fmt.Errorf("%w: object with more than %d keys", ErrLimitExceeded, l.MaxKeys)
+		}
+		if object[key], err = l.decode(decoder, depth+1); err != nil {
+			return nil, err
+		}
+	}
+	// closing '}'
+	if _, err := decoder.Token(); err != nil {
+		return nil, truncated(err)
+	}
+	return object, nil
+}
+
+func (l *JSONLimits) decodeArray(decoder *json.Decoder, depth int) (interface{}, error) {
+	list := []interface{}{}
+	for decoder.More() {
+		if l.MaxArrayLength > 0 && len(list) >= l.MaxArrayLength {
+			return nil, fmt.Errorf("%w: array longer than %d", ErrLimitExceeded, l.MaxArrayLength)
+		}
+		item, err := l.decode(decoder, depth+1)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, item)
+	}
+	// closing ']'
+	if _, err := decoder.Token(); err != nil {
+		return nil, truncated(err)
+	}
+	return list, nil
+}
+
+// truncated turns the io.EOF of a body that ends inside a value into
+// io.ErrUnexpectedEOF; only an empty body may end before the first token.
+func truncated(err error) error {
+	if err == io.EOF {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
+
+func (l *JSONLimits) checkString(s string) error {
+	if l.MaxStringLength > 0 && len(s) > l.MaxStringLength && utf8.RuneCountInString(s) > l.MaxStringLength {
+		return fmt.Errorf("%w: string longer than %d", ErrLimitExceeded, l.MaxStringLength)
+	}
+	return nil
+}
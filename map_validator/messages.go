@@ -0,0 +1,302 @@
+package map_validator
+
+import (
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// DefaultLocale is the catalog used when no locale is picked for a run, and
+// the fallback for keys missing from the picked catalog.
+const DefaultLocale = "en"
+
+// Message keys that are not rule codes. Every Code* constant is also a
+// message key; these cover the prefix and the variants of a code.
+const (
+	// MsgFieldPrefix is prepended to per-field messages, e.g. "the field 'x' ".
+	MsgFieldPrefix = "field_prefix"
+	// MsgRequiredValue is used instead of CodeRequired by ValidateValue
+	// when no field name is given.
+	MsgRequiredValue = "required_value"
+	// MsgTypeElement and MsgTypeElementInteger are used for primitive list
+	// elements of the wrong type.
+	MsgTypeElement        = "type_element"
+	MsgTypeElementInteger = "type_element_integer"
+	// MsgTypeString is used when a Regex rule gets a non-string value.
+	MsgTypeString = "type_string"
+	// MsgListObject is used when a ListObject value is not a list of objects.
+	MsgListObject = "list_object"
+	// MsgListIndex is used when a form list skips an index (items[0],
+	// items[2]).
+	MsgListIndex = "list_index"
+	// MsgEnumUnsupported is used when the enum items have an unsupported kind.
+	MsgEnumUnsupported = "enum_unsupported"
+	// MsgMinExclusive and MsgMaxExclusive are used instead of CodeMin and
+	// CodeMax for the exclusive number bounds (Gt, Lt, Positive, Negative).
+	MsgMinExclusive = "min_exclusive"
+	MsgMaxExclusive = "max_exclusive"
+	// MsgUniqueItems and MsgUniqueItemKeys are used instead of CodeUnique
+	// for repeated list items (ListRules.Unique, Rules.UniqueKeys).
+	MsgUniqueItems    = "unique_items"
+	MsgUniqueItemKeys = "unique_item_keys"
+	// MsgOneOfMany is used instead of CodeOneOf when more than one branch
+	// of a OneOf rule passed.
+	MsgOneOfMany = "one_of_many"
+	// MsgExtensionDenied is used instead of CodeFileExtension when the
+	// extension is in FileRules.DeniedExtensions.
+	MsgExtensionDenied = "extension_denied"
+)
+
+var (
+	catalogMu sync.RWMutex
+	catalogs  = map[string]map[string]string{
+		"en": {
+			MsgFieldPrefix:        "the field '${field}' ",
+			CodeRequired:          "we need '${field}' field",
+			MsgRequiredValue:      "value is required",
+			CodeType:              "should be '${expected_type}'",
+			CodeRange:             "value ${actual_value} is out of range for '${expected_type}'",
+			MsgTypeElement:        "should be ${expected_type}",
+			MsgTypeElementInteger: "should be integer",
+			MsgTypeString:         "should be string",
+			CodeMin:               "should be or greater than ${expected_min_length}",
+			CodeMax:               "should be or lower than ${expected_max_length}",
+			MsgMinExclusive:       "should be greater than ${expected_min_length}",
+			MsgMaxExclusive:       "should be lower than ${expected_max_length}",
+			CodeNonZero:           "should not be zero",
+			CodeMultipleOf:        "should be a multiple of ${multiple_of}",
+			CodeAllOf:             "does not match all rules: ${branch_errors}",
+			CodeAnyOf:             "does not match any rule: ${branch_errors}",
+			CodeOneOf:             "does not match any rule: ${branch_errors}",
+			MsgOneOfMany:          "matches more than one rule: ${matched_branches}",
+			CodeNot:               "matches a rule it should not",
+			CodeEqField:           "should be equal to '${compare_field}'",
+			CodeNeField:           "should be different from '${compare_field}'",
+			CodeGtField:           "should be greater than '${compare_field}'",
+			CodeGteField:          "should be or greater than '${compare_field}'",
+			CodeLtField:           "should be lower than '${compare_field}'",
+			CodeLteField:          "should be or lower than '${compare_field}'",
+			CodeList:              "is not valid list",
+			MsgListObject:         "is not valid list object",
+			MsgListIndex:          "has no item at index ${missing_index}",
+			CodeObject:            "is not valid object",
+			CodeRegex:             "is not valid regex",
+			CodeEnum:              "value is not in enum list${enum_values}",
+			MsgEnumUnsupported:    "enum is not supported for type '${actual_type}'",
+			CodeUUID:              "is not valid uuid",
+			CodeEmail:             "is not valid email",
+			CodeIPv4:              "is not valid IP",
+			CodeIPv4Network:       "is not valid IP Network",
+			CodeIPv4Prefix:        "is not valid IP",
+			CodeStrictUnknownKey:  "'${field}' is not allowed key",
+			CodeRequiredWithout:   "if field '${field}' is null you need to put value in ${dependencies} field",
+			CodeRequiredIf:        "if field '${field}' is filled you need to put value in ${dependencies} field also",
+			CodeRequiredWhen:      "we need '${field}' field when ${condition}",
+			CodeForbiddenWhen:     "'${field}' is not allowed when ${condition}",
+			CodeUnique:            "value of '${unique_origin}' and '${unique_target}' fields must be different",
+			MsgUniqueItems:        "has duplicate items at index ${first_index} and ${duplicate_index}",
+			MsgUniqueItemKeys:     "has duplicate ${unique_keys} at index ${first_index} and ${duplicate_index}",
+			CodeSourceConflict:    "should be sent in the ${source}, not in the body",
+			CodeFile:              "is not valid file",
+			CodeFileMinSize:       "should be at least ${expected_min_length} bytes",
+			CodeFileMaxSize:       "should be at most ${expected_max_length} bytes",
+			CodeFileType:          "has type '${mime_type}', allowed: ${allowed_types}",
+			CodeFileExtension:     "has extension '${extension}' which is not allowed",
+			MsgExtensionDenied:    "has extension '${extension}' which is denied",
+			CodeImage:             "is not valid image",
+			CodeImageFormat:       "has image format '${image_format}', allowed: ${allowed_types}",
+			CodeImageDimension:    "has dimensions ${image_width}x${image_height} which are out of range",
+			CodeImageAspectRatio:  "has aspect ratio ${aspect_ratio}, expected ${expected_aspect_ratio}",
+		},
+		"id": {
+			MsgFieldPrefix:        "field '${field}' ",
+			CodeRequired:          "field '${field}' wajib diisi",
+			MsgRequiredValue:      "nilai wajib diisi",
+			CodeType:              "harus bertipe '${expected_type}'",
+			CodeRange:             "nilai ${actual_value} di luar jangkauan '${expected_type}'",
+			MsgTypeElement:        "harus bertipe ${expected_type}",
+			MsgTypeElementInteger: "harus berupa bilangan bulat",
+			MsgTypeString:         "harus berupa string",
+			CodeMin:               "minimal ${expected_min_length}",
+			CodeMax:               "maksimal ${expected_max_length}",
+			MsgMinExclusive:       "harus lebih dari ${expected_min_length}",
+			MsgMaxExclusive:       "harus kurang dari ${expected_max_length}",
+			CodeNonZero:           "tidak boleh nol",
+			CodeMultipleOf:        "harus kelipatan ${multiple_of}",
+			CodeAllOf:             "tidak memenuhi semua aturan: ${branch_errors}",
+			CodeAnyOf:             "tidak memenuhi satu pun aturan: ${branch_errors}",
+			CodeOneOf:             "tidak memenuhi satu pun aturan: ${branch_errors}",
+			MsgOneOfMany:          "memenuhi lebih dari satu aturan: ${matched_branches}",
+			CodeNot:               "memenuhi aturan yang tidak diperbolehkan",
+			CodeEqField:           "harus sama dengan field '${compare_field}'",
+			CodeNeField:           "harus berbeda dengan field '${compare_field}'",
+			CodeGtField:           "harus lebih dari field '${compare_field}'",
+			CodeGteField:          "minimal sama dengan field '${compare_field}'",
+			CodeLtField:           "harus kurang dari field '${compare_field}'",
+			CodeLteField:          "maksimal sama dengan field '${compare_field}'",
+			CodeList:              "bukan list yang valid",
+			MsgListObject:         "bukan list object yang valid",
+			MsgListIndex:          "tidak memiliki item di indeks ${missing_index}",
+			CodeObject:            "bukan object yang valid",
+			CodeRegex:             "formatnya tidak sesuai",
+			CodeEnum:              "nilainya tidak ada di daftar enum ${enum_values}",
+			MsgEnumUnsupported:    "enum tidak didukung untuk tipe '${actual_type}'",
+			CodeUUID:              "bukan uuid yang valid",
+			CodeEmail:             "bukan email yang valid",
+			CodeIPv4:              "bukan IP yang valid",
+			CodeIPv4Network:       "bukan IP Network yang valid",
+			CodeIPv4Prefix:        "bukan IP yang valid",
+			CodeStrictUnknownKey:  "key '${field}' tidak diperbolehkan",
+			CodeRequiredWithout:   "jika field '${field}' kosong, field ${dependencies} wajib diisi",
+			CodeRequiredIf:        "jika field '${field}' diisi, field ${dependencies} juga wajib diisi",
+			CodeRequiredWhen:      "field '${field}' wajib diisi jika ${condition}",
+			CodeForbiddenWhen:     "field '${field}' tidak boleh diisi jika ${condition}",
+			CodeUnique:            "nilai field '${unique_origin}' dan '${unique_target}' harus berbeda",
+			MsgUniqueItems:        "memiliki item duplikat di indeks ${first_index} dan ${duplicate_index}",
+			MsgUniqueItemKeys:     "memiliki ${unique_keys} duplikat di indeks ${first_index} dan ${duplicate_index}",
+			CodeSourceConflict:    "harus dikirim lewat ${source}, bukan body",
+			CodeFile:              "bukan file yang valid",
+			CodeFileMinSize:       "minimal ${expected_min_length} byte",
+			CodeFileMaxSize:       "maksimal ${expected_max_length} byte",
+			CodeFileType:          "bertipe '${mime_type}', yang diperbolehkan: ${allowed_types}",
+			CodeFileExtension:     "berekstensi '${extension}' yang tidak diperbolehkan",
+			MsgExtensionDenied:    "berekstensi '${extension}' yang dilarang",
+			CodeImage:             "bukan gambar yang valid",
+			CodeImageFormat:       "berformat '${image_format}', yang diperbolehkan: ${allowed_types}",
+			CodeImageDimension:    "berukuran ${image_width}x${image_height} di luar batas",
+			CodeImageAspectRatio:  "memiliki rasio ${aspect_ratio}, seharusnya ${expected_aspect_ratio}",
+		},
+	}
+)
+
+// RegisterMessages adds or overrides message templates for a locale. Keys are
+// the Code* and Msg* constants; templates use the same ${...} variables as
+// CustomMsg. Keys missing from a locale fall back to DefaultLocale.
+//
+// It is safe to call concurrently with running validations, although
+// catalogs are normally registered once at startup.
+func RegisterMessages(locale string, messages map[string]string) {
+	locale = normalizeLocale(locale)
+	catalogMu.Lock()
+	defer catalogMu.Unlock()
+	merged := map[string]string{}
+	for key, msg := range catalogs[locale] {
+		merged[key] = msg
+	}
+	for key, msg := range messages {
+		merged[key] = msg
+	}
+	catalogs[locale] = merged
+}
+
+// HasLocale reports whether a catalog is registered for locale or for its
+// base language (e.g. "id" for "id-ID").
+func HasLocale(locale string) bool {
+	return resolveLocale(locale) != ""
+}
+
+func normalizeLocale(locale string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(locale), "_", "-"))
+}
+
+// resolveLocale returns the registered catalog name for locale, trying the
+// base language when the full tag is not registered.
+func resolveLocale(locale string) string {
+	locale = normalizeLocale(locale)
+	catalogMu.RLock()
+	defer catalogMu.RUnlock()
+	if _, ok := catalogs[locale]; ok {
+		return locale
+	}
+	if base, _, found := strings.Cut(locale, "-"); found {
+		if _, ok := catalogs[base]; ok {
+			return base
+		}
+	}
+	return ""
+}
+
+func lookupMessage(locale, key string) string {
+	resolved := resolveLocale(locale)
+	catalogMu.RLock()
+	defer catalogMu.RUnlock()
+	if msg, ok := catalogs[resolved][key]; ok {
+		return msg
+	}
+	return catalogs[DefaultLocale][key]
+}
+
+// LocaleFromRequest picks the best registered locale from the request's
+// Accept-Language header, honouring q-values. It returns "" when the header
+// is missing or names no registered catalog.
+func LocaleFromRequest(r *http.Request) string {
+	if r == nil {
+		return ""
+	}
+	type candidate struct {
+		locale string
+		q      float64
+	}
+	var candidates []candidate
+	for _, part := range strings.Split(r.Header.Get("Accept-Language"), ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		if tag == "" || tag == "*" {
+			continue
+		}
+		q := 1.0
+		if value, found := strings.CutPrefix(strings.TrimSpace(params), "q="); found {
+			parsed, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				continue
+			}
+			q = parsed
+		}
+		candidates = append(candidates, candidate{locale: tag, q: q})
+	}
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].q > candidates[j].q })
+	for _, c := range candidates {
+		if c.q <= 0 {
+			continue
+		}
+		if resolved := resolveLocale(c.locale); resolved != "" {
+			return resolved
+		}
+	}
+	return ""
+}
+
+// LocalizeError re-renders the default messages of err (a FieldError or a
+// ValidationErrors) with the catalog of locale. Messages that come from a
+// CustomMsg template are left as they are. Other errors are returned
+// unchanged.
+func LocalizeError(err error, locale string) error {
+	switch e := err.(type) {
+	case *FieldError:
+		for _, branch := range e.Branches {
+			LocalizeError(branch, locale)
+		}
+		e.locale = locale
+		e.render()
+	case ValidationErrors:
+		for _, item := range e {
+			LocalizeError(item, locale)
+		}
+	}
+	return err
+}
+
+var messageVarPattern = regexp.MustCompile(`\$\{([a-z_]+)\}`)
+
+// renderMessage replaces ${name} placeholders with vars in a single pass;
+// unknown placeholders are kept as they are.
+func renderMessage(msg string, vars map[string]string) string {
+	return messageVarPattern.ReplaceAllStringFunc(msg, func(placeholder string) string {
+		if value, ok := vars[placeholder[2:len(placeholder)-1]]; ok {
+			return value
+		}
+		return placeholder
+	})
+}
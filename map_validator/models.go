@@ -21,21 +21,110 @@ type MessageMeta struct {
 	UniqueTarget      *string
 	ActualValue       *string
 	EnumValues        *string
+	Dependencies      *string
+	UnknownKey        *string
+	Source            *string
+	ActualSize        *int64
+	MimeType          *string
+	AllowedTypes      *string
+	Extension         *string
+	AllowedExtensions *string
+	DeniedExtensions  *string
+	ImageFormat       *string
+	ImageWidth        *int64
+	ImageHeight       *int64
+	AspectRatio       *string
+	ExpectedRatio     *string
+	// ActualNumber is the checked value of a number rule (int64, uint64
+	// or float64); it fills ${actual_value} and ${actual_length}.
+	ActualNumber      interface{}
+	ExpectedMinNumber *float64
+	ExpectedMaxNumber *float64
+	MultipleOf        *float64
+	FirstIndex        *int64
+	DuplicateIndex    *int64
+	MissingIndex      *int64
+	UniqueKeys        *string
+	MatchedBranches   *string
+	CompareField      *string
+	CompareValue      *string
+	Condition         *string
+	ConditionField    *string
+	ConditionValues   *string
 }
 
 type EnumField[T any] struct {
-	Items               T
-	StringCaseSensitive bool // will support soon
+	Items T
+	// Deprecated: string enums are case-sensitive unless CaseInsensitive
+	// is set; this field is ignored.
+	StringCaseSensitive bool
+
+	// The options below apply to string enums. Normalize, then TrimSpace,
+	// run on both the value and the items before they are compared.
+	CaseInsensitive bool
+	TrimSpace       bool
+	// Normalize is an extra normalisation step, e.g. norm.NFC.String from
+	// golang.org/x/text/unicode/norm for Unicode normal form C.
+	Normalize func(string) string
+	// Canonical replaces the value with the matching item as it is spelled
+	// in Items, so Bind gets "admin" for " ADMIN ".
+	Canonical bool
 }
 
 type CustomMsg struct {
 	OnTypeNotMatch      *string
 	OnEnumValueNotMatch *string
-	//OnNull              *string
-	OnMax         *string
-	OnMin         *string
-	OnRegexString *string
-	OnUnique      *string
+	OnNull              *string
+	OnMax               *string
+	OnMin               *string
+	OnRegexString       *string
+	OnUnique            *string
+
+	// OnRequiredWithout / OnRequiredIf are read from the rule that declares
+	// RequiredWithout / RequiredIf; ${field} is the field being reported and
+	// ${dependencies} the declaring fields.
+	OnRequiredWithout *string
+	OnRequiredIf      *string
+	// OnRequiredWhen / OnForbiddenWhen are read from the rule itself;
+	// ${condition} is the condition that holds.
+	OnRequiredWhen  *string
+	OnForbiddenWhen *string
+
+	OnEmail              *string
+	OnUUID               *string
+	OnIPV4               *string
+	OnIPV4Network        *string
+	OnIPv4OptionalPrefix *string
+	OnObject             *string
+	OnList               *string
+
+	// File rules; see FileRules for the template variables.
+	OnFile          *string
+	OnFileMinSize   *string
+	OnFileMaxSize   *string
+	OnFileType      *string
+	OnFileExtension *string
+
+	// Image rules; see ImageRules for the template variables.
+	OnImage            *string
+	OnImageFormat      *string
+	OnImageDimension   *string
+	OnImageAspectRatio *string
+
+	// Number rules; see NumberRules for the template variables.
+	OnNonZero    *string
+	OnMultipleOf *string
+
+	// Combinators; ${branch_errors} lists the failed branches and
+	// ${matched_branches} the branches that passed a OneOf.
+	OnAllOf *string
+	OnAnyOf *string
+	OnOneOf *string
+	OnNot   *string
+
+	// OnCompareField is used by every FieldCompare of the rule;
+	// ${compare_field} is the other field and ${compare_value} its value.
+	OnCompareField *string
 }
 
 func (cm *CustomMsg) uniqueNotNil() bool {
@@ -84,11 +173,38 @@ func (cm *CustomMsg) isNotNil() (notNil bool) {
 	if cm.OnUnique != nil {
 		notNil = true
 	}
+	for _, msg := range []*string{
+		cm.OnNull, cm.OnRequiredWithout, cm.OnRequiredIf, cm.OnEmail, cm.OnUUID,
+		cm.OnIPV4, cm.OnIPV4Network, cm.OnIPv4OptionalPrefix, cm.OnObject, cm.OnList,
+		cm.OnFile, cm.OnFileMinSize, cm.OnFileMaxSize, cm.OnFileType, cm.OnFileExtension,
+		cm.OnImage, cm.OnImageFormat, cm.OnImageDimension, cm.OnImageAspectRatio,
+		cm.OnNonZero, cm.OnMultipleOf, cm.OnAllOf, cm.OnAnyOf, cm.OnOneOf, cm.OnNot,
+		cm.OnCompareField, cm.OnRequiredWhen, cm.OnForbiddenWhen,
+	} {
+		if msg != nil {
+			notNil = true
+		}
+	}
 	return notNil
 }
 
 type Setting struct {
 	Strict bool
+	// OnUnknownKey is the message template for keys rejected by Strict.
+	// ${field} and ${unknown_key} hold the rejected key.
+	OnUnknownKey *string
+	// AllErrors makes RunValidate collect every failure into a
+	// ValidationErrors value instead of returning the first one. Only the
+	// setting of the top-level rules group is consulted.
+	AllErrors bool
+	// JSONLimits caps the request body read by LoadJsonHttp and
+	// LoadRequestHttp. Only the top-level rules group is consulted;
+	// NewValidateBuilder().SetJSONLimits overrides it.
+	JSONLimits *JSONLimits
+	// UseNumber decodes JSON numbers with json.Decoder.UseNumber so integer
+	// rules are checked (and bound) exactly, without a float64 round-trip.
+	// Only the top-level rules group is consulted.
+	UseNumber bool
 }
 
 // rulesWrapper implements RulesWrapper
@@ -101,8 +217,9 @@ type rulesWrapper struct {
 }
 
 type ListRules struct {
-	Min    *int64
-	Max    *int64
+	Min *int64
+	Max *int64
+	// Unique rejects primitive lists with repeated elements.
 	Unique bool
 }
 
@@ -123,33 +240,67 @@ type Rules struct {
 	IPV4Network        bool
 	IPv4OptionalPrefix bool
 	File               bool
+	FileRules          *FileRules
+	NumberRules        *NumberRules
 	RegexString        string
 	Unique             []string
+	// UniqueKeys are the ListOfObject item keys whose values must not
+	// repeat across items (compared together); see UniqueItems.
+	UniqueKeys []string
+	// Compare checks the value against sibling fields; see FieldCompare.
+	Compare []FieldCompare
 
 	RequiredWithout []string
 	RequiredIf      []string
+	RequiredWhen    []Condition
+	ForbiddenWhen   []Condition
 	Object          RulesWrapper
 	ListObject      RulesWrapper
 	List            ListRulesWrapper
 
+	// Combinators wrap other rules; see AllOf, AnyOf, OneOf and Not.
+	AllOf []Rules
+	AnyOf []Rules
+	OneOf []Rules
+	Not   *Rules
+
+	// Source is only read by LoadRequestHttp, for top-level rules.
+	// SourceName is the header name for SourceHeader (defaults to the rule
+	// key), also used by LoadHeaderHttp.
+	Source     ValueSource
+	SourceName string
+
 	CustomMsg CustomMsg // will support soon
 }
 
 type FileRequest struct {
 	File     multipart.File
 	FileInfo *multipart.FileHeader
+	// Image is set once an Image rule has checked the file, so handlers
+	// don't need to decode it again.
+	Image *ImageInfo
 }
 
 type ruleState struct {
 	rules              RulesWrapper
 	extension          []ExtensionType
 	strictAllowedValue bool
+	locale             string
+	acceptLanguage     bool
+	pathExtractor      PathParamExtractor
+	jsonLimits         *JSONLimits
+	useNumber          bool
 }
 
 type dataState struct {
 	rules              RulesWrapper
 	extension          []ExtensionType
 	strictAllowedValue bool
+	locale             string
+	acceptLanguage     bool
+	pathExtractor      PathParamExtractor
+	jsonLimits         *JSONLimits
+	useNumber          bool
 }
 
 type finalOperation struct {
@@ -157,6 +308,7 @@ type finalOperation struct {
 	loadedFrom loadFromType
 	extension  []ExtensionType
 	data       map[string]interface{}
+	locale     string
 }
 
 type ExtraOperationData struct {
@@ -0,0 +1,157 @@
+package map_validator
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+)
+
+// numberKind is the kind a number is checked against: the rule's Type, or
+// the kind of its enum items.
+func numberKind(validator Rules) reflect.Kind {
+	if validator.Enum != nil {
+		if enumType := reflect.TypeOf(validator.Enum.Items); enumType != nil && enumType.Kind() == reflect.Slice {
+			return enumType.Elem().Kind()
+		}
+	}
+	return validator.Type
+}
+
+// normalizeJSONNumber converts a json.Number (UseNumber mode) into the
+// declared kind without going through float64, so int64 / uint64 values
+// above 2^53 keep every digit. Rules that don't declare a number kind get a
+// float64, as in the default decoding mode.
+func normalizeJSONNumber(num json.Number, validator Rules, field string) (interface{}, error) {
+	kind := numberKind(validator)
+	switch kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		value, err := strconv.ParseInt(num.String(), 10, kindBits(kind))
+		if err == nil {
+			return reflect.ValueOf(value).Convert(kindType(kind)).Interface(), nil
+		}
+		return integerFromNumber(num, err, kind, validator, field)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		value, err := strconv.ParseUint(num.String(), 10, kindBits(kind))
+		if err == nil {
+			return reflect.ValueOf(value).Convert(kindType(kind)).Interface(), nil
+		}
+		return integerFromNumber(num, err, kind, validator, field)
+	case reflect.Float32:
+		value, err := strconv.ParseFloat(num.String(), 32)
+		if err != nil {
+			return nil, numberError(num, err, kind, validator, field)
+		}
+		return float32(value), nil
+	}
+	value, err := num.Float64()
+	if err != nil {
+		return nil, numberError(num, err, reflect.Float64, validator, field)
+	}
+	return value, nil
+}
+
+// integerFromNumber handles a json.Number that strconv refused for an
+// integer kind: "-1" for an unsigned kind, "1.5", or "1e3" which is still a
+// whole number.
+func integerFromNumber(num json.Number, err error, kind reflect.Kind, validator Rules, field string) (interface{}, error) {
+	if errors.Is(err, strconv.ErrRange) {
+		return nil, rangeError(num.String(), kind, validator, field)
+	}
+	value, err := num.Float64()
+	if err != nil {
+		return nil, numberError(num, err, kind, validator, field)
+	}
+	if err := integerError(value, kind, validator, field); err != nil {
+		return nil, err
+	}
+	return reflect.ValueOf(value).Convert(kindType(kind)).Interface(), nil
+}
+
+// integerError checks a number against an integer kind: floats must be
+// whole, and the value must fit the kind (no negatives for unsigned kinds).
+// It returns nil for non-integer kinds and non-number data.
+func integerError(data interface{}, kind reflect.Kind, validator Rules, field string) error {
+	target := kindType(kind)
+	if target == nil {
+		return nil
+	}
+	bounds := reflect.New(target).Elem()
+	unsigned := kind >= reflect.Uint && kind <= reflect.Uint64
+	value := reflect.ValueOf(data)
+	overflow := false
+	switch value.Kind() {
+	case reflect.Float32, reflect.Float64:
+		f := value.Float()
+		if math.IsNaN(f) || math.IsInf(f, 0) || f != math.Trunc(f) {
+			actualType := value.Kind()
+			meta := MessageMeta{
+				Field:        &field,
+				ExpectedType: &kind,
+				ActualType:   &actualType,
+			}
+			if validator.CustomMsg.OnTypeNotMatch != nil {
+				return buildMessage(CodeType, *validator.CustomMsg.OnTypeNotMatch, meta)
+			}
+			return buildErrorMessage(field, CodeType, meta)
+		}
+		if unsigned {
+			overflow = f < 0 || f >= math.Ldexp(1, kindBits(kind))
+		} else {
+			limit := math.Ldexp(1, kindBits(kind)-1)
+			overflow = f < -limit || f >= limit
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i := value.Int()
+		if unsigned {
+			overflow = i < 0 || bounds.OverflowUint(uint64(i))
+		} else {
+			overflow = bounds.OverflowInt(i)
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u := value.Uint()
+		if unsigned {
+			overflow = bounds.OverflowUint(u)
+		} else {
+			overflow = u > math.MaxInt64 || bounds.OverflowInt(int64(u))
+		}
+	default:
+		return nil
+	}
+	if overflow {
+		return rangeError(fmt.Sprintf("%v", data), kind, validator, field)
+	}
+	return nil
+}
+
+// numberError reports a number that doesn't fit kind: out of range for the
+// kind, or not an integer (fraction, exponent) for an integer kind.
+func numberError(num json.Number, err error, kind reflect.Kind, validator Rules, field string) error {
+	if errors.Is(err, strconv.ErrRange) {
+		return rangeError(num.String(), kind, validator, field)
+	}
+	actualType := reflect.Float64
+	meta := MessageMeta{
+		Field:        &field,
+		ExpectedType: &kind,
+		ActualType:   &actualType,
+	}
+	if validator.CustomMsg.OnTypeNotMatch != nil {
+		return buildMessage(CodeType, *validator.CustomMsg.OnTypeNotMatch, meta)
+	}
+	return buildErrorMessage(field, CodeType, meta)
+}
+
+func rangeError(value string, kind reflect.Kind, validator Rules, field string) error {
+	meta := MessageMeta{
+		Field:        &field,
+		ExpectedType: &kind,
+		ActualValue:  &value,
+	}
+	if validator.CustomMsg.OnTypeNotMatch != nil {
+		return buildMessage(CodeRange, *validator.CustomMsg.OnTypeNotMatch, meta)
+	}
+	return buildErrorMessage(field, CodeRange, meta)
+}
@@ -0,0 +1,200 @@
+package map_validator
+
+import (
+	"encoding/json"
+	"math"
+	"reflect"
+	"strconv"
+)
+
+// NumberRules are value checks for number rules (every int, uint and float
+// kind), on top of the whole-number Min/Max of Rules. Values are compared as
+// numbers, so negative and fractional values are handled exactly.
+//
+// Template variables for the CustomMsg hooks:
+//   - ${actual_value} / ${actual_length}: the value that was checked
+//   - ${expected_min_length} / ${expected_max_length}: the violated bound
+//     (OnMin for Gte, Gt and Positive; OnMax for Lte, Lt and Negative)
+//   - ${multiple_of}: the step (OnMultipleOf)
+type NumberRules struct {
+	// Gte / Lte are inclusive bounds, Gt / Lt exclusive ones.
+	Gte *float64
+	Lte *float64
+	Gt  *float64
+	Lt  *float64
+	// Positive is Gt 0, Negative is Lt 0.
+	Positive bool
+	Negative bool
+	NonZero  bool
+	// MultipleOf requires value / MultipleOf to be a whole number; 0 means
+	// no check.
+	MultipleOf float64
+}
+
+// numberRules returns a copy so chained Rules values never share NumberRules
+func (r Rules) numberRules() NumberRules {
+	if r.NumberRules == nil {
+		return NumberRules{}
+	}
+	return *r.NumberRules
+}
+
+// numberValue returns data as float64; exact is set for integer kinds and
+// whole json.Number values (UseNumber mode).
+func numberValue(data interface{}) (f float64, exact interface{}, ok bool) {
+	if num, isNumber := data.(json.Number); isNumber {
+		if i, err := strconv.ParseInt(num.String(), 10, 64); err == nil {
+			return float64(i), i, true
+		}
+		if u, err := strconv.ParseUint(num.String(), 10, 64); err == nil {
+			return float64(u), u, true
+		}
+		if f, err := num.Float64(); err == nil {
+			return f, nil, true
+		}
+		return 0, nil, false
+	}
+	value := reflect.ValueOf(data)
+	switch value.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(value.Int()), value.Int(), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(value.Uint()), value.Uint(), true
+	case reflect.Float32, reflect.Float64:
+		return value.Float(), nil, true
+	}
+	return 0, nil, false
+}
+
+// compareInt compares data with an integer bound without going through
+// float64 when data is an integer kind.
+func compareInt(f float64, exact interface{}, bound int64) int {
+	switch v := exact.(type) {
+	case int64:
+		return compareOrdered(v, bound)
+	case uint64:
+		if bound < 0 || v > math.MaxInt64 {
+			return 1
+		}
+		return compareOrdered(int64(v), bound)
+	}
+	return compareOrdered(f, float64(bound))
+}
+
+func compareOrdered[T int64 | float64](a, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// numberActual is the value reported in errors: whole numbers as int64 (or
+// uint64), fractions as float64.
+func numberActual(f float64, exact interface{}) interface{} {
+	if exact != nil {
+		return exact
+	}
+	if f == math.Trunc(f) && math.Abs(f) < 1<<63 {
+		return int64(f)
+	}
+	return f
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+// isMultipleOf reports whether value is step times a whole number. Integer
+// values with a whole step are checked exactly, floats with a relative
+// tolerance so 0.3 is a multiple of 0.1.
+func isMultipleOf(f float64, exact interface{}, step float64) bool {
+	step = math.Abs(step)
+	if step == math.Trunc(step) && step < 1<<63 {
+		switch v := exact.(type) {
+		case int64:
+			return v%int64(step) == 0
+		case uint64:
+			return v%uint64(step) == 0
+		}
+	}
+	quotient := f / step
+	return math.Abs(quotient-math.Round(quotient)) <= 1e-9*math.Max(1, math.Abs(quotient))
+}
+
+// validateNumber runs the Min/Max bounds and the NumberRules of a number
+// value. Non-number data is left to the other checks.
+func validateNumber(data interface{}, min, max *int64, rules *NumberRules, custom CustomMsg, field string) error {
+	f, exact, ok := numberValue(data)
+	if !ok {
+		return nil
+	}
+	actual := numberActual(f, exact)
+	minError := func(key string, bound interface{}) error {
+		meta := MessageMeta{ActualNumber: actual}
+		switch b := bound.(type) {
+		case int64:
+			meta.ExpectedMinLength = &b
+		case float64:
+			meta.ExpectedMinNumber = &b
+		}
+		if custom.OnMin != nil {
+			return buildRuleMessage(custom.OnMin, field, CodeMin, meta)
+		}
+		return buildErrorMessageKey(field, CodeMin, key, meta)
+	}
+	maxError := func(key string, bound interface{}) error {
+		meta := MessageMeta{ActualNumber: actual}
+		switch b := bound.(type) {
+		case int64:
+			meta.ExpectedMaxLength = &b
+		case float64:
+			meta.ExpectedMaxNumber = &b
+		}
+		if custom.OnMax != nil {
+			return buildRuleMessage(custom.OnMax, field, CodeMax, meta)
+		}
+		return buildErrorMessageKey(field, CodeMax, key, meta)
+	}
+
+	if min != nil && compareInt(f, exact, *min) < 0 {
+		return minError(CodeMin, *min)
+	}
+	if max != nil && compareInt(f, exact, *max) > 0 {
+		return maxError(CodeMax, *max)
+	}
+	if rules == nil {
+		return nil
+	}
+	if rules.Gte != nil && f < *rules.Gte {
+		return minError(CodeMin, *rules.Gte)
+	}
+	if rules.Gt != nil && f <= *rules.Gt {
+		return minError(MsgMinExclusive, *rules.Gt)
+	}
+	if rules.Positive && compareInt(f, exact, 0) <= 0 {
+		return minError(MsgMinExclusive, float64(0))
+	}
+	if rules.Lte != nil && f > *rules.Lte {
+		return maxError(CodeMax, *rules.Lte)
+	}
+	if rules.Lt != nil && f >= *rules.Lt {
+		return maxError(MsgMaxExclusive, *rules.Lt)
+	}
+	if rules.Negative && compareInt(f, exact, 0) >= 0 {
+		return maxError(MsgMaxExclusive, float64(0))
+	}
+	if rules.NonZero && f == 0 {
+		return buildRuleMessage(custom.OnNonZero, field, CodeNonZero, MessageMeta{ActualNumber: actual})
+	}
+	if rules.MultipleOf != 0 && !isMultipleOf(f, exact, rules.MultipleOf) {
+		step := rules.MultipleOf
+		return buildRuleMessage(custom.OnMultipleOf, field, CodeMultipleOf, MessageMeta{
+			ActualNumber: actual,
+			MultipleOf:   &step,
+		})
+	}
+	return nil
+}
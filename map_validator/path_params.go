@@ -0,0 +1,28 @@
+package map_validator
+
+import "net/http"
+
+// PathParamExtractor reads a path parameter by name from a routed request.
+// ok is false when the route has no such parameter.
+//
+// Implement it to plug in a router without this package importing it, e.g.
+// for chi:
+//
+//	map_validator.PathParamFunc(func(r *http.Request, name string) (string, bool) {
+//		v := chi.URLParam(r, name)
+//		return v, v != ""
+//	})
+type PathParamExtractor interface {
+	PathParam(r *http.Request, name string) (string, bool)
+}
+
+// PathParamFunc adapts a plain function to PathParamExtractor.
+type PathParamFunc func(r *http.Request, name string) (string, bool)
+
+func (f PathParamFunc) PathParam(r *http.Request, name string) (string, bool) {
+	return f(r, name)
+}
+
+// DefaultPathParamExtractor reads parameters set by net/http's ServeMux
+// patterns (Go 1.22+ r.PathValue). On older Go versions it finds nothing.
+var DefaultPathParamExtractor PathParamExtractor = PathParamFunc(stdPathValue)
@@ -0,0 +1,9 @@
+//go:build !go1.22
+
+package map_validator
+
+import "net/http"
+
+func stdPathValue(r *http.Request, name string) (string, bool) {
+	return "", false
+}
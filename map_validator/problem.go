@@ -0,0 +1,139 @@
+package map_validator
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+)
+
+// ProblemContentType is the media type written by ProblemResponder.
+const ProblemContentType = "application/problem+json"
+
+// Problem is an RFC 9457 problem details body. Errors lists one entry per
+// failed field when the error came from validation.
+type Problem struct {
+	Type   string         `json:"type"`
+	Title  string         `json:"title"`
+	Status int            `json:"status"`
+	Detail string         `json:"detail,omitempty"`
+	Errors []ProblemField `json:"errors,omitempty"`
+}
+
+// ProblemField is a single entry of Problem.Errors.
+type ProblemField struct {
+	Path    string `json:"path"`
+	Pointer string `json:"pointer,omitempty"`
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// ProblemResponder turns the error returned by ValidateJSON / RunValidate
+// into a problem+json response.
+//
+//   - validation failures (FieldError / ValidationErrors) use Status,
+//     422 Unprocessable Entity by default;
+//   - ErrInvalidJsonFormat is answered with 400 Bad Request;
+//   - ErrLimitExceeded is answered with 413 Content Too Large;
+//   - anything else (ErrNoRules, extension errors, ...) is a server side
+//     problem and is answered with 500 without leaking the error text,
+//     unless ExposeInternalErrors is set.
+//
+// The zero value is ready to use.
+type ProblemResponder struct {
+	Status               int
+	Type                 string
+	Title                string
+	ExposeInternalErrors bool
+}
+
+// Problem builds the body for err without writing it.
+func (pr ProblemResponder) Problem(err error) Problem {
+	if fields := problemFields(err); len(fields) > 0 {
+		p := Problem{
+			Type:   pr.Type,
+			Title:  pr.Title,
+			Status: pr.Status,
+			Errors: fields,
+		}
+		if p.Type == "" {
+			p.Type = "about:blank"
+		}
+		if p.Status == 0 {
+			p.Status = http.StatusUnprocessableEntity
+		}
+		if p.Title == "" {
+			p.Title = "Validation failed"
+		}
+		return p
+	}
+	if errors.Is(err, ErrInvalidJsonFormat) {
+		return Problem{
+			Type:   "about:blank",
+			Title:  http.StatusText(http.StatusBadRequest),
+			Status: http.StatusBadRequest,
+			Detail: "request body " + err.Error(),
+		}
+	}
+	if errors.Is(err, ErrLimitExceeded) {
+		return Problem{
+			Type:   "about:blank",
+			Title:  http.StatusText(http.StatusRequestEntityTooLarge),
+			Status: http.StatusRequestEntityTooLarge,
+			Detail: err.Error(),
+		}
+	}
+	p := Problem{
+		Type:   "about:blank",
+		Title:  http.StatusText(http.StatusInternalServerError),
+		Status: http.StatusInternalServerError,
+	}
+	if pr.ExposeInternalErrors && err != nil {
+		p.Detail = err.Error()
+	}
+	return p
+}
+
+// Write writes err as a problem+json response. It does nothing for a nil
+// error so it can be called unconditionally.
+func (pr ProblemResponder) Write(w http.ResponseWriter, err error) error {
+	if err == nil {
+		return nil
+	}
+	p := pr.Problem(err)
+	w.Header().Set("Content-Type", ProblemContentType)
+	w.WriteHeader(p.Status)
+	return json.NewEncoder(w).Encode(p)
+}
+
+// WriteProblem writes err with the default ProblemResponder.
+//
+//	dto, err := map_validator.ValidateJSON[CreateUser](r, rules)
+//	if err != nil {
+//		map_validator.WriteProblem(w, err)
+//		return
+//	}
+func WriteProblem(w http.ResponseWriter, err error) error {
+	return ProblemResponder{}.Write(w, err)
+}
+
+func problemFields(err error) []ProblemField {
+	var fieldErrs []*FieldError
+	var all ValidationErrors
+	var fe *FieldError
+	switch {
+	case errors.As(err, &all):
+		fieldErrs = all.FieldErrors()
+	case errors.As(err, &fe):
+		fieldErrs = []*FieldError{fe}
+	}
+	res := make([]ProblemField, 0, len(fieldErrs))
+	for _, fe := range fieldErrs {
+		res = append(res, ProblemField{
+			Path:    fe.Path,
+			Pointer: fe.Pointer,
+			Code:    fe.Code,
+			Message: fe.Message,
+		})
+	}
+	return res
+}
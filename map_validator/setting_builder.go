@@ -9,6 +9,26 @@ func (s *Setting) MakeStrict() *Setting {
 	return s
 }
 
+func (s *Setting) WithUnknownKeyMsg(msg string) *Setting {
+	s.OnUnknownKey = &msg
+	return s
+}
+
+func (s *Setting) MakeAllErrors() *Setting {
+	s.AllErrors = true
+	return s
+}
+
+func (s *Setting) WithJSONLimits(limits JSONLimits) *Setting {
+	s.JSONLimits = &limits
+	return s
+}
+
+func (s *Setting) MakeUseNumber() *Setting {
+	s.UseNumber = true
+	return s
+}
+
 func (s *Setting) Done() Setting {
 	return *s
 }
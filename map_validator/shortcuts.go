@@ -25,6 +25,12 @@ func Email() Rules   { return Rules{Email: true} }
 func UUID() Rules    { return Rules{UUID: true} }
 func IPv4() Rules    { return Rules{IPV4: true} }
 
+// File is a multipart file upload, bound to FileRequest. Wrap it in List to
+// accept several files under the same key (bound to []FileRequest):
+//
+//	SetRule("photos", List(File()).Between(1, 5))
+func File() Rules { return Rules{File: true} }
+
 // --- Enum constructors (base-typed to avoid reflect gymnastics at call site) ---
 
 func StrEnum(items ...string) Rules {
@@ -35,6 +41,33 @@ func IntEnum(items ...int) Rules {
 	return Rules{Type: reflect.Int, Enum: &EnumField[any]{Items: items}}
 }
 
+// --- Enum options (see EnumField) ---
+//
+// They copy the EnumField so the constructor's value is never shared, and do
+// nothing on rules without an Enum.
+
+func (r Rules) enumOption(set func(e *EnumField[any])) Rules {
+	if r.Enum == nil {
+		return r
+	}
+	enum := *r.Enum
+	set(&enum)
+	r.Enum = &enum
+	return r
+}
+func (r Rules) EnumIgnoreCase() Rules {
+	return r.enumOption(func(e *EnumField[any]) { e.CaseInsensitive = true })
+}
+func (r Rules) EnumTrimSpace() Rules {
+	return r.enumOption(func(e *EnumField[any]) { e.TrimSpace = true })
+}
+func (r Rules) EnumNormalize(fn func(string) string) Rules {
+	return r.enumOption(func(e *EnumField[any]) { e.Normalize = fn })
+}
+func (r Rules) EnumCanonical() Rules {
+	return r.enumOption(func(e *EnumField[any]) { e.Canonical = true })
+}
+
 // --- Nesting shortcuts ---
 
 func NestedObject(w RulesWrapper) Rules { return Rules{Object: w} }
@@ -102,6 +135,58 @@ func (r Rules) Between(min, max int64) Rules {
 func (r Rules) Regex(pattern string) Rules        { r.RegexString = pattern; return r }
 func (r Rules) WithMsg(m CustomMsg) Rules         { r.CustomMsg = m; return r }
 func (r Rules) UniqueFrom(fields ...string) Rules { r.Unique = fields; return r }
+
+// UniqueItems rejects repeated list items. Without keys it applies to
+// List(...) rules and compares whole elements; for ListOfObject rules pass
+// the item keys that must not repeat:
+//
+//	List(Str()).UniqueItems()
+//	ListOfObject(lineItem).UniqueItems("sku")
+func (r Rules) UniqueItems(keys ...string) Rules {
+	if len(keys) > 0 {
+		r.UniqueKeys = keys
+		return r
+	}
+	if r.List != nil {
+		lr := r.listRules()
+		lr.Unique = true
+		r.List = BuildListRoles().SetListRule(lr)
+	}
+	return r
+}
+
+// EqField, NeField, GtField, GteField, LtField and LteField compare the
+// value with a sibling field (see FieldCompare):
+//
+//	SetRule("password_confirmation", Str().EqField("password"))
+//	SetRule("end_date", Str().GtField("start_date"))
+func (r Rules) EqField(field string) Rules  { return r.compareWith(CompareEq, field) }
+func (r Rules) NeField(field string) Rules  { return r.compareWith(CompareNe, field) }
+func (r Rules) GtField(field string) Rules  { return r.compareWith(CompareGt, field) }
+func (r Rules) GteField(field string) Rules { return r.compareWith(CompareGte, field) }
+func (r Rules) LtField(field string) Rules  { return r.compareWith(CompareLt, field) }
+func (r Rules) LteField(field string) Rules { return r.compareWith(CompareLte, field) }
+
+// compareWith appends to a copy so chained Rules values never share Compare
+func (r Rules) compareWith(op CompareOp, field string) Rules {
+	r.Compare = append(append([]FieldCompare(nil), r.Compare...), FieldCompare{Op: op, Field: field})
+	return r
+}
+
+// WithRequiredWhen requires the value when one of the conditions holds and
+// makes it optional otherwise; WithForbiddenWhen rejects it when one holds:
+//
+//	SetRule("tracking_number", Str().WithRequiredWhen(FieldEquals("status", "shipped")))
+//	SetRule("company_name", Str().WithRequiredWhen(FieldIn("account_type", "business", "enterprise")))
+//	SetRule("discount_code", Str().Nullable().WithForbiddenWhen(FieldEquals("plan", "free")))
+func (r Rules) WithRequiredWhen(conditions ...Condition) Rules {
+	r.RequiredWhen = append(append([]Condition(nil), r.RequiredWhen...), conditions...)
+	return r
+}
+func (r Rules) WithForbiddenWhen(conditions ...Condition) Rules {
+	r.ForbiddenWhen = append(append([]Condition(nil), r.ForbiddenWhen...), conditions...)
+	return r
+}
 func (r Rules) WithRequiredIf(fields ...string) Rules {
 	r.RequiredIf = fields
 	return r
@@ -110,3 +195,99 @@ func (r Rules) WithRequiredWithout(fields ...string) Rules {
 	r.RequiredWithout = fields
 	return r
 }
+
+// --- Number helpers (see NumberRules) ---
+//
+// Bounds take float64 so they work for every number kind; WithMin / WithMax
+// stay available for whole-number bounds.
+
+func (r Rules) WithNumberRules(nr NumberRules) Rules { r.NumberRules = &nr; return r }
+func (r Rules) Gte(n float64) Rules {
+	nr := r.numberRules()
+	nr.Gte = &n
+	return r.WithNumberRules(nr)
+}
+func (r Rules) Lte(n float64) Rules {
+	nr := r.numberRules()
+	nr.Lte = &n
+	return r.WithNumberRules(nr)
+}
+func (r Rules) Gt(n float64) Rules {
+	nr := r.numberRules()
+	nr.Gt = &n
+	return r.WithNumberRules(nr)
+}
+func (r Rules) Lt(n float64) Rules {
+	nr := r.numberRules()
+	nr.Lt = &n
+	return r.WithNumberRules(nr)
+}
+func (r Rules) Positive() Rules {
+	nr := r.numberRules()
+	nr.Positive = true
+	return r.WithNumberRules(nr)
+}
+func (r Rules) Negative() Rules {
+	nr := r.numberRules()
+	nr.Negative = true
+	return r.WithNumberRules(nr)
+}
+func (r Rules) NonZero() Rules {
+	nr := r.numberRules()
+	nr.NonZero = true
+	return r.WithNumberRules(nr)
+}
+func (r Rules) MultipleOf(step float64) Rules {
+	nr := r.numberRules()
+	nr.MultipleOf = step
+	return r.WithNumberRules(nr)
+}
+
+// --- Source helpers (read by LoadRequestHttp) ---
+
+func (r Rules) FromQuery() Rules { r.Source = SourceQuery; return r }
+func (r Rules) FromPath() Rules  { r.Source = SourcePath; return r }
+func (r Rules) FromHeader(name string) Rules {
+	r.Source = SourceHeader
+	r.SourceName = name
+	return r
+}
+
+// --- File helpers (see FileRules) ---
+
+func (r Rules) WithFileRules(fr FileRules) Rules { r.File = true; r.FileRules = &fr; return r }
+func (r Rules) MinSize(bytes int64) Rules {
+	fr := r.fileRules()
+	fr.MinSize = bytes
+	return r.WithFileRules(fr)
+}
+func (r Rules) MaxSize(bytes int64) Rules {
+	fr := r.fileRules()
+	fr.MaxSize = bytes
+	return r.WithFileRules(fr)
+}
+func (r Rules) AllowTypes(mimeTypes ...string) Rules {
+	fr := r.fileRules()
+	fr.AllowedTypes = mimeTypes
+	return r.WithFileRules(fr)
+}
+func (r Rules) AllowExtensions(extensions ...string) Rules {
+	fr := r.fileRules()
+	fr.AllowedExtensions = extensions
+	return r.WithFileRules(fr)
+}
+func (r Rules) DenyExtensions(extensions ...string) Rules {
+	fr := r.fileRules()
+	fr.DeniedExtensions = extensions
+	return r.WithFileRules(fr)
+}
+func (r Rules) SanitizeName() Rules {
+	fr := r.fileRules()
+	fr.SanitizeName = true
+	return r.WithFileRules(fr)
+}
+func (r Rules) WithImage(ir ImageRules) Rules {
+	fr := r.fileRules()
+	fr.Image = &ir
+	return r.WithFileRules(fr)
+}
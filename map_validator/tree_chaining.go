@@ -10,9 +10,13 @@ type chainState struct {
 	value       interface{}
 	CustomMsg   *CustomMsg
 	uniques     []string
+	compares    []FieldCompare
 	errs        []error
 	parent      *chainState
 	children    []*chainState
+	// scope is where a detached root (a ListOfObject item) sits in the
+	// payload
+	scope fieldPath
 }
 
 func (cs *chainState) AddError(err error) ChainerType {
@@ -236,14 +240,16 @@ func (cs *chainState) RunUniqueChecker() {
 				originKey := cs.GetKey()
 				targetKey := bro.GetKey()
 				if targetKey == unique && bro.GetValue() == cs.GetValue() {
-					msgError := fmt.Errorf("value of '%s' and '%s' fields must be different", originKey, targetKey)
+					meta := MessageMeta{
+						Field:        &originKey,
+						UniqueOrigin: &originKey,
+						UniqueTarget: &targetKey,
+					}
+					msgError := buildCatalogMessage(CodeUnique, CodeUnique, meta)
 					if cs.CustomMsg != nil && cs.CustomMsg.uniqueNotNil() {
-						msgError = buildMessage(*cs.CustomMsg.OnUnique, MessageMeta{
-							Field:        &originKey,
-							UniqueOrigin: &originKey,
-							UniqueTarget: &targetKey,
-						})
+						msgError = buildMessage(CodeUnique, *cs.CustomMsg.OnUnique, meta)
 					}
+					msgError.setPath(cs.path())
 					cs.AddError(msgError)
 				}
 			}
@@ -254,6 +260,46 @@ func (cs *chainState) RunUniqueChecker() {
 	}
 }
 
+func (cs *chainState) setCompares(compares []FieldCompare) {
+	cs.compares = compares
+}
+
+func (cs *chainState) runCompareChecker() {
+	if cs.value != nil && len(cs.compares) > 0 {
+		brothers := cs.GetBrothers()
+		for _, cmp := range cs.compares {
+			for _, bro := range brothers {
+				if bro.GetKey() != cmp.Field || bro.GetValue() == nil {
+					continue
+				}
+				if !cmp.Op.holds(cs.value, bro.GetValue()) {
+					msgError := compareError(cs.CustomMsg, cs.GetKey(), cmp, cs.value, bro.GetValue())
+					msgError.rel = cs.path()
+					msgError.setPath(msgError.rel)
+					cs.AddError(msgError)
+				}
+			}
+		}
+	}
+	for _, child := range cs.children {
+		child.runCompareChecker()
+	}
+}
+
+// path rebuilds the payload path of the node from its ancestors' keys
+func (cs *chainState) path() fieldPath {
+	var keys []string
+	current := cs
+	for ; current.parent != nil; current = current.parent {
+		keys = append(keys, current.key)
+	}
+	path := current.scope
+	for i := len(keys) - 1; i >= 0; i-- {
+		path = path.child(keys[i])
+	}
+	return path
+}
+
 func (cs *chainState) RunManipulator() (err error) {
 	return cs.runManipulate()
 }
@@ -0,0 +1,96 @@
+package map_validator
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// itemKey identifies a list item for duplicate detection. Comparable values
+// are used as they are; maps, slices and object keys are compared by their
+// JSON encoding.
+type itemKey struct {
+	value   interface{}
+	encoded string
+}
+
+func newItemKey(v interface{}) itemKey {
+	if v == nil || reflect.TypeOf(v).Comparable() {
+		return itemKey{value: v}
+	}
+	encoded, _ := json.Marshal(v)
+	return itemKey{encoded: string(encoded)}
+}
+
+// duplicateItems returns the indices of the first repeated item. With keys,
+// items are objects compared on those keys together; items that have none
+// of the keys set are never duplicates.
+func duplicateItems(items []interface{}, keys []string) (first, dup int, found bool) {
+	seen := map[itemKey]int{}
+	for i, item := range items {
+		key := newItemKey(item)
+		if len(keys) > 0 {
+			object, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			values := make([]interface{}, len(keys))
+			empty := true
+			for k, name := range keys {
+				values[k] = object[name]
+				if values[k] != nil {
+					empty = false
+				}
+			}
+			if empty {
+				continue
+			}
+			encoded, _ := json.Marshal(values)
+			key = itemKey{encoded: string(encoded)}
+		}
+		if j, ok := seen[key]; ok {
+			return j, i, true
+		}
+		seen[key] = i
+	}
+	return 0, 0, false
+}
+
+// uniqueItemsError reports items first and dup of list field as duplicates.
+// The error points at the repeated item.
+func uniqueItemsError(custom CustomMsg, field string, keys []string, first, dup int) error {
+	origin := fmt.Sprintf("%s[%d]", field, first)
+	target := fmt.Sprintf("%s[%d]", field, dup)
+	firstIndex, dupIndex := int64(first), int64(dup)
+	meta := MessageMeta{
+		Field:          &field,
+		UniqueOrigin:   &origin,
+		UniqueTarget:   &target,
+		FirstIndex:     &firstIndex,
+		DuplicateIndex: &dupIndex,
+	}
+	msgKey := MsgUniqueItems
+	if len(keys) > 0 {
+		uniqueKeys := strings.Join(keys, ", ")
+		meta.UniqueKeys = &uniqueKeys
+		msgKey = MsgUniqueItemKeys
+	}
+	var fe *FieldError
+	if custom.OnUnique != nil {
+		fe = buildMessage(CodeUnique, *custom.OnUnique, meta)
+	} else {
+		fe = buildErrorMessageKey(field, CodeUnique, msgKey, meta)
+	}
+	fe.Expected = keys
+	fe.Actual = []int{first, dup}
+	return indexError(fe, field, dup)
+}
+
+// listRules returns the ListRules of a List(...) rule
+func (r Rules) listRules() ListRules {
+	if lr, ok := r.List.(*rulesWrapper); ok {
+		return lr.ListRules
+	}
+	return ListRules{}
+}
@@ -16,6 +16,10 @@ const (
 	FromHttpMultipartForm
 	FromMapString
 	FromJSONEncoder
+	FromHttpQuery
+	FromHttpPath
+	FromHttpRequest
+	FromHttpHeader
 )
 
 // Keep backward compatibility with internal names
@@ -26,8 +30,37 @@ const (
 	fromHttpMultipartForm = FromHttpMultipartForm
 	fromMapString         = FromMapString
 	fromJSONEncoder       = FromJSONEncoder
+	fromHttpQuery         = FromHttpQuery
+	fromHttpPath          = FromHttpPath
+	fromHttpRequest       = FromHttpRequest
+	fromHttpHeader        = FromHttpHeader
 )
 
+// ValueSource tells LoadRequestHttp where a rule reads its value from.
+type ValueSource int
+
+const (
+	SourceBody ValueSource = iota
+	SourceQuery
+	SourcePath
+	SourceHeader
+)
+
+func (s ValueSource) String() string {
+	switch s {
+	case SourceQuery:
+		return "query"
+	case SourcePath:
+		return "path"
+	case SourceHeader:
+		return "header"
+	}
+	return "body"
+}
+
 const (
 	chainKey = "root"
+
+	// defaultMaxFormMemory matches net/http's limit for r.FormValue
+	defaultMaxFormMemory = 32 << 20
 )
@@ -0,0 +1,167 @@
+package test
+
+import (
+	"bytes"
+	"errors"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/Rhyanz46/go-map-validator/map_validator"
+)
+
+func TestRunValidateAllCollectsEveryFailure(t *testing.T) {
+	address := map_validator.BuildRoles().
+		SetRule("city", map_validator.Str().WithMin(3)).
+		SetRule("zip", map_validator.Str())
+	rules := map_validator.BuildRoles().
+		SetRule("email", map_validator.Email()).
+		SetRule("name", map_validator.Str().WithMax(3)).
+		SetRule("address", map_validator.NestedObject(address)).
+		Done()
+
+	check, err := map_validator.NewValidateBuilder().SetRules(rules).Load(map[string]interface{}{
+		"email":   "invalid",
+		"name":    "too long",
+		"address": map[string]interface{}{"city": "x"},
+	})
+	if err != nil {
+		t.Fatalf("Expected not have error, but got error : %s", err)
+	}
+	_, err = check.RunValidateAll()
+	var validationErrs map_validator.ValidationErrors
+	if !errors.As(err, &validationErrs) {
+		t.Fatalf("Expected ValidationErrors, but got %v", err)
+	}
+	if len(validationErrs) != 4 {
+		t.Fatalf("Expected 4 errors, but got %d : %s", len(validationErrs), err)
+	}
+	for _, expected := range []string{
+		"the field 'email' is not valid email",
+		"the field 'name' should be or lower than 3",
+		"the field 'city' should be or greater than 3",
+		"we need 'zip' field",
+	} {
+		if !strings.Contains(err.Error(), expected) {
+			t.Errorf("Expected error contains '%s', but we got %s", expected, err)
+		}
+	}
+}
+
+func TestRunValidateAllListObjectAndStrict(t *testing.T) {
+	item := map_validator.BuildRoles().
+		SetRule("sku", map_validator.Str()).
+		SetRule("qty", map_validator.Int().WithMin(1))
+	rules := map_validator.BuildRoles().
+		SetRule("items", map_validator.ListOfObject(item)).
+		SetSetting(map_validator.BuildSetting().MakeStrict().Done()).
+		Done()
+
+	check, err := map_validator.NewValidateBuilder().SetRules(rules).Load(map[string]interface{}{
+		"items": []interface{}{
+			map[string]interface{}{"sku": "a", "qty": 0},
+			map[string]interface{}{"qty": 2},
+		},
+		"unknown": true,
+	})
+	if err != nil {
+		t.Fatalf("Expected not have error, but got error : %s", err)
+	}
+	_, err = check.RunValidateAll()
+	var validationErrs map_validator.ValidationErrors
+	if !errors.As(err, &validationErrs) || len(validationErrs) != 3 {
+		t.Fatalf("Expected 3 aggregated errors, but got %v", err)
+	}
+	if !strings.Contains(err.Error(), "'unknown' is not allowed key") {
+		t.Errorf("Expected strict key error, but got %s", err)
+	}
+}
+
+func TestRunValidateAllPrimitiveListElements(t *testing.T) {
+	address := map_validator.BuildRoles().SetRule("lines", map_validator.List(map_validator.Str().WithMin(2)))
+	rules := map_validator.BuildRoles().
+		SetRule("tags", map_validator.List(map_validator.Str())).
+		SetRule("address", map_validator.NestedObject(address)).
+		Done()
+	payload := map[string]interface{}{
+		"tags":    []interface{}{"a", 1, "b", true},
+		"address": map[string]interface{}{"lines": []interface{}{"ok", "x", "y"}},
+	}
+
+	check, err := map_validator.NewValidateBuilder().SetRules(rules).Load(payload)
+	if err != nil {
+		t.Fatalf("Expected not have error, but got error : %s", err)
+	}
+	_, err = check.RunValidateAll()
+	var validationErrs map_validator.ValidationErrors
+	if !errors.As(err, &validationErrs) {
+		t.Fatalf("Expected ValidationErrors, but got %v", err)
+	}
+	var paths []string
+	for _, fieldErr := range validationErrs.FieldErrors() {
+		paths = append(paths, fieldErr.Path)
+	}
+	if strings.Join(paths, ",") != "address.lines[1],address.lines[2],tags[1],tags[3]" {
+		t.Errorf("Expected every failed element, but got %v", paths)
+	}
+
+	// first-error mode still stops at the first element
+	_, err = check.RunValidate()
+	var fieldErr *map_validator.FieldError
+	if !errors.As(err, &fieldErr) || fieldErr.Path != "address.lines[1]" {
+		t.Errorf("Expected a single error at address.lines[1], but got %v", err)
+	}
+}
+
+func TestRunValidateAllRequiredWithout(t *testing.T) {
+	rules := map_validator.BuildRoles().
+		SetRule("name", map_validator.Str().WithMin(10)).
+		SetRule("flavor", map_validator.Str().WithRequiredWithout("custom_flavor")).
+		SetRule("custom_flavor", map_validator.Str().WithRequiredWithout("flavor")).
+		Done()
+
+	check, err := map_validator.NewValidateBuilder().SetRules(rules).Load(map[string]interface{}{"name": "short"})
+	if err != nil {
+		t.Fatalf("Expected not have error, but got error : %s", err)
+	}
+	_, err = check.RunValidateAll()
+	if err == nil {
+		t.Fatal("Expected error, but got nil")
+	}
+	if !strings.Contains(err.Error(), "should be or greater than 10") ||
+		!strings.Contains(err.Error(), "you need to put value in") {
+		t.Errorf("Expected min and required without errors, but got %s", err)
+	}
+}
+
+func TestRunValidateAllValidPayload(t *testing.T) {
+	rules := map_validator.BuildRoles().
+		SetRule("name", map_validator.Str()).
+		Done()
+	check, err := map_validator.NewValidateBuilder().SetRules(rules).Load(map[string]interface{}{"name": "ok"})
+	if err != nil {
+		t.Fatalf("Expected not have error, but got error : %s", err)
+	}
+	res, err := check.RunValidateAll()
+	if err != nil {
+		t.Fatalf("Expected not have error, but got error : %s", err)
+	}
+	if res.GetData()["name"] != "ok" {
+		t.Errorf("Expected name 'ok', but got %v", res.GetData()["name"])
+	}
+}
+
+func TestAllErrorsSettingWithValidateJSON(t *testing.T) {
+	req := httptest.NewRequest("POST", "/test", bytes.NewBufferString(`{"email": "bad", "age": "x"}`))
+	rules := map_validator.BuildRoles().
+		SetRule("email", map_validator.Email()).
+		SetRule("age", map_validator.Int()).
+		SetSetting(map_validator.BuildSetting().MakeAllErrors().Done()).
+		Done()
+
+	_, err := map_validator.ValidateJSON[map[string]interface{}](req, rules)
+	var validationErrs map_validator.ValidationErrors
+	if !errors.As(err, &validationErrs) || len(validationErrs) != 2 {
+		t.Fatalf("Expected 2 aggregated errors, but got %v", err)
+	}
+}
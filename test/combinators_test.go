@@ -0,0 +1,144 @@
+package test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Rhyanz46/go-map-validator/map_validator"
+)
+
+const hostnamePattern = `^[a-z0-9]([a-z0-9.-]*[a-z0-9])?$`
+
+func TestCombinatorRules(t *testing.T) {
+	testCases := []struct {
+		name     string
+		rule     map_validator.Rules
+		value    interface{}
+		code     string
+		expected string
+	}{
+		{"any of passes ip", map_validator.AnyOf(map_validator.IPv4(), map_validator.Str().Regex(hostnamePattern)), "10.0.0.1", "", ""},
+		{"any of passes host", map_validator.AnyOf(map_validator.IPv4(), map_validator.Str().Regex(hostnamePattern)), "api.example.com", "", ""},
+		{
+			"any of fails", map_validator.AnyOf(map_validator.IPv4(), map_validator.Str().Regex(hostnamePattern)), "bad host!",
+			map_validator.CodeAnyOf, "the field 'v' does not match any rule: [0] is not valid IP; [1] is not valid regex",
+		},
+		{"all of passes", map_validator.AllOf(map_validator.Str().WithMax(20), map_validator.Email()), "a@b.co", "", ""},
+		{
+			"all of lists every failure", map_validator.AllOf(map_validator.Str().WithMax(5), map_validator.Email(), map_validator.Str()), "not-an-email",
+			map_validator.CodeAllOf, "the field 'v' does not match all rules: [0] should be or lower than 5; [1] is not valid email",
+		},
+		{"one of passes", map_validator.OneOf(map_validator.Int().WithMax(3), map_validator.Int().WithMin(10)), 12, "", ""},
+		{
+			"one of many", map_validator.OneOf(map_validator.Int().WithMax(10), map_validator.Int().WithMin(5)), 7,
+			map_validator.CodeOneOf, "the field 'v' matches more than one rule: [0 1]",
+		},
+		{"not passes", map_validator.AllOf(map_validator.Str(), map_validator.Not(map_validator.StrEnum("root", "admin"))), "bob", "", ""},
+		{
+			"not fails", map_validator.Not(map_validator.StrEnum("root", "admin")), "root",
+			map_validator.CodeNot, "the field 'v' matches a rule it should not",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rules := map_validator.BuildRoles().SetRule("v", tc.rule).Done()
+			check, err := map_validator.NewValidateBuilder().SetRules(rules).Load(map[string]interface{}{"v": tc.value})
+			if err != nil {
+				t.Fatalf("Expected not have error, but got error : %s", err)
+			}
+			_, err = check.RunValidate()
+			if tc.code == "" {
+				if err != nil {
+					t.Errorf("Expected not have error, but got error : %s", err)
+				}
+				return
+			}
+			var fieldErr *map_validator.FieldError
+			if !errors.As(err, &fieldErr) || fieldErr.Code != tc.code || err.Error() != tc.expected {
+				t.Errorf("Expected %s '%s', but got %v", tc.code, tc.expected, err)
+			}
+		})
+	}
+}
+
+func TestCombinatorBranches(t *testing.T) {
+	rules := map_validator.BuildRoles().
+		SetRule("contact", map_validator.AllOf(map_validator.Str().WithMax(5), map_validator.Email(), map_validator.Str())).
+		Done()
+	check, err := map_validator.NewValidateBuilder().SetRules(rules).Load(map[string]interface{}{"contact": "not-an-email"})
+	if err != nil {
+		t.Fatalf("Expected not have error, but got error : %s", err)
+	}
+	_, err = check.RunValidate()
+	var fieldErr *map_validator.FieldError
+	if !errors.As(err, &fieldErr) || len(fieldErr.Branches) != 3 {
+		t.Fatalf("Expected 3 branches, but got %v", err)
+	}
+	var branchErr *map_validator.FieldError
+	if !errors.As(fieldErr.Branches[1], &branchErr) || branchErr.Code != map_validator.CodeEmail || fieldErr.Branches[2] != nil {
+		t.Errorf("Expected email failure and a passing last branch, but got %v", fieldErr.Branches)
+	}
+}
+
+func TestCombinatorNesting(t *testing.T) {
+	host := map_validator.AnyOf(map_validator.IPv4(), map_validator.Str().Regex(hostnamePattern))
+	server := map_validator.BuildRoles().SetRule("host", host)
+	testCases := []struct {
+		name    string
+		rule    map_validator.Rules
+		payload interface{}
+		path    string
+	}{
+		{"list", map_validator.List(host), []interface{}{"10.0.0.1", "bad host!"}, "v[1]"},
+		{"nested object", map_validator.NestedObject(server), map[string]interface{}{"host": "bad host!"}, "v.host"},
+		{"list of object", map_validator.ListOfObject(server), []interface{}{
+			map[string]interface{}{"host": "db.internal"},
+			map[string]interface{}{"host": "bad host!"},
+		}, "v[1].host"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rules := map_validator.BuildRoles().SetRule("v", tc.rule).Done()
+			check, err := map_validator.NewValidateBuilder().SetRules(rules).Load(map[string]interface{}{"v": tc.payload})
+			if err != nil {
+				t.Fatalf("Expected not have error, but got error : %s", err)
+			}
+			_, err = check.RunValidate()
+			var fieldErr *map_validator.FieldError
+			if !errors.As(err, &fieldErr) || fieldErr.Code != map_validator.CodeAnyOf || fieldErr.Path != tc.path {
+				t.Fatalf("Expected any_of error at %s, but got %v", tc.path, err)
+			}
+			var branchErr *map_validator.FieldError
+			if !errors.As(fieldErr.Branches[0], &branchErr) || branchErr.Path != tc.path {
+				t.Errorf("Expected branch error at %s, but got %v", tc.path, fieldErr.Branches[0])
+			}
+		})
+	}
+}
+
+func TestCombinatorLocaleAndCustomMessage(t *testing.T) {
+	rules := map_validator.BuildRoles().
+		SetRule("host", map_validator.AnyOf(map_validator.IPv4(), map_validator.Str().Regex(hostnamePattern))).
+		SetRule("role", map_validator.Not(map_validator.StrEnum("root")).WithMsg(map_validator.CustomMsg{
+			OnNot: map_validator.SetMessage("${field} is reserved"),
+		})).
+		Done()
+	check, err := map_validator.NewValidateBuilder().SetLocale("id").SetRules(rules).Load(map[string]interface{}{"host": "bad host!", "role": "bob"})
+	if err != nil {
+		t.Fatalf("Expected not have error, but got error : %s", err)
+	}
+	_, err = check.RunValidate()
+	expected := "field 'host' tidak memenuhi satu pun aturan: [0] bukan IP yang valid; [1] formatnya tidak sesuai"
+	if err == nil || err.Error() != expected {
+		t.Errorf("Expected '%s', but got %v", expected, err)
+	}
+
+	check, err = map_validator.NewValidateBuilder().SetRules(rules).Load(map[string]interface{}{"host": "10.0.0.1", "role": "root"})
+	if err != nil {
+		t.Fatalf("Expected not have error, but got error : %s", err)
+	}
+	_, err = check.RunValidate()
+	if err == nil || err.Error() != "role is reserved" {
+		t.Errorf("Expected custom message, but got %v", err)
+	}
+}
@@ -0,0 +1,203 @@
+package test
+
+import (
+	"bytes"
+	"errors"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Rhyanz46/go-map-validator/map_validator"
+)
+
+func TestCompareFields(t *testing.T) {
+	testCases := []struct {
+		name    string
+		rule    map_validator.Rules
+		other   map_validator.Rules
+		payload map[string]interface{}
+		code    string
+	}{
+		{"eq passes", map_validator.Str().EqField("other"), map_validator.Str(), map[string]interface{}{"v": "secret", "other": "secret"}, ""},
+		{"eq fails", map_validator.Str().EqField("other"), map_validator.Str(), map[string]interface{}{"v": "secret", "other": "Secret"}, map_validator.CodeEqField},
+		{"ne fails", map_validator.Str().NeField("other"), map_validator.Str(), map[string]interface{}{"v": "a", "other": "a"}, map_validator.CodeNeField},
+		{"gte numbers", map_validator.Float64().GteField("other"), map_validator.Float64(), map[string]interface{}{"v": 10.0, "other": 10.0}, ""},
+		{"gte fails", map_validator.Float64().GteField("other"), map_validator.Float64(), map[string]interface{}{"v": 9.5, "other": 10.0}, map_validator.CodeGteField},
+		{"gt mixed kinds", map_validator.Int().GtField("other"), map_validator.Float64(), map[string]interface{}{"v": 3, "other": 2.5}, ""},
+		{"lt fails", map_validator.Int().LtField("other"), map_validator.Int(), map[string]interface{}{"v": 10, "other": 9}, map_validator.CodeLtField},
+		{"lte passes", map_validator.Int().LteField("other"), map_validator.Int(), map[string]interface{}{"v": -3, "other": -3}, ""},
+		{"dates", map_validator.Str().GtField("other"), map_validator.Str(), map[string]interface{}{"v": "2024-03-01", "other": "2024-02-28"}, ""},
+		{"date times", map_validator.Str().GtField("other"), map_validator.Str(), map[string]interface{}{"v": "2024-03-01T08:00:00+07:00", "other": "2024-03-01T02:00:00Z"}, map_validator.CodeGtField},
+		{"null target skipped", map_validator.Str().EqField("other"), map_validator.Str().Nullable(), map[string]interface{}{"v": "a"}, ""},
+		{"not ordered", map_validator.Bool().GtField("other"), map_validator.Bool(), map[string]interface{}{"v": true, "other": false}, map_validator.CodeGtField},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rules := map_validator.BuildRoles().SetRule("v", tc.rule).SetRule("other", tc.other).Done()
+			check, err := map_validator.NewValidateBuilder().SetRules(rules).Load(tc.payload)
+			if err != nil {
+				t.Fatalf("Expected not have error, but got error : %s", err)
+			}
+			_, err = check.RunValidate()
+			if tc.code == "" {
+				if err != nil {
+					t.Errorf("Expected not have error, but got error : %s", err)
+				}
+				return
+			}
+			var fieldErr *map_validator.FieldError
+			if !errors.As(err, &fieldErr) || fieldErr.Code != tc.code || fieldErr.Field != "v" {
+				t.Errorf("Expected %s error, but got %v", tc.code, err)
+			}
+		})
+	}
+}
+
+func TestCompareFieldsAfterManipulator(t *testing.T) {
+	parseDate := func(data interface{}) (interface{}, error) {
+		return time.Parse("02/01/2006", data.(string))
+	}
+	rules := map_validator.BuildRoles().
+		SetRule("start_date", map_validator.Str()).
+		SetRule("end_date", map_validator.Str().GtField("start_date").WithMsg(map_validator.CustomMsg{
+			OnCompareField: map_validator.SetMessage("${field} must be after ${compare_field}"),
+		})).
+		SetFieldsManipulator([]string{"start_date", "end_date"}, parseDate).
+		Done()
+	// 02/03 is before 10/02 as text, but after it as a date
+	check, err := map_validator.NewValidateBuilder().SetRules(rules).Load(map[string]interface{}{"start_date": "10/02/2024", "end_date": "02/03/2024"})
+	if err != nil {
+		t.Fatalf("Expected not have error, but got error : %s", err)
+	}
+	if _, err = check.RunValidate(); err != nil {
+		t.Errorf("Expected not have error, but got error : %s", err)
+	}
+
+	check, err = map_validator.NewValidateBuilder().SetRules(rules).Load(map[string]interface{}{"start_date": "10/02/2024", "end_date": "09/02/2024"})
+	if err != nil {
+		t.Fatalf("Expected not have error, but got error : %s", err)
+	}
+	_, err = check.RunValidate()
+	if err == nil || err.Error() != "end_date must be after start_date" {
+		t.Errorf("Expected custom message, but got %v", err)
+	}
+}
+
+func TestCompareFieldsMessagesAndPaths(t *testing.T) {
+	price := map_validator.BuildRoles().
+		SetRule("min_price", map_validator.Int()).
+		SetRule("max_price", map_validator.Int().GteField("min_price"))
+	rules := map_validator.BuildRoles().
+		SetRule("password", map_validator.Str()).
+		SetRule("password_confirmation", map_validator.Str().EqField("password")).
+		SetRule("filter", map_validator.NestedObject(price)).
+		SetRule("ranges", map_validator.ListOfObject(price)).
+		SetSetting(map_validator.BuildSetting().MakeAllErrors().Done()).
+		Done()
+	payload := map[string]interface{}{
+		"password":              "secret",
+		"password_confirmation": "secrets",
+		"filter":                map[string]interface{}{"min_price": 10, "max_price": 5},
+		"ranges": []interface{}{
+			map[string]interface{}{"min_price": 1, "max_price": 2},
+			map[string]interface{}{"min_price": 7, "max_price": 3},
+		},
+	}
+	check, err := map_validator.NewValidateBuilder().SetRules(rules).Load(payload)
+	if err != nil {
+		t.Fatalf("Expected not have error, but got error : %s", err)
+	}
+	_, err = check.RunValidate()
+	var errs map_validator.ValidationErrors
+	if !errors.As(err, &errs) {
+		t.Fatalf("Expected ValidationErrors, but got %v", err)
+	}
+	paths := map[string]string{}
+	for _, fieldErr := range errs.FieldErrors() {
+		paths[fieldErr.Path] = fieldErr.Message
+	}
+	expected := map[string]string{
+		"password_confirmation": "the field 'password_confirmation' should be equal to 'password'",
+		"filter.max_price":      "the field 'max_price' should be or greater than 'min_price'",
+		"ranges[1].max_price":   "the field 'max_price' should be or greater than 'min_price'",
+	}
+	if len(paths) != len(expected) {
+		t.Fatalf("Expected %v, but got %v", expected, paths)
+	}
+	for path, msg := range expected {
+		if paths[path] != msg {
+			t.Errorf("Expected '%s' at %s, but got %v", msg, path, paths)
+		}
+	}
+}
+
+func TestCompareFieldsUseNumber(t *testing.T) {
+	rules := map_validator.BuildRoles().
+		SetRule("min_price", map_validator.Any()).
+		SetRule("max_price", map_validator.Any().GtField("min_price")).
+		SetRule("copies", map_validator.Int64().EqField("max_price")).
+		Done()
+	testCases := []struct {
+		body string
+		code string
+	}{
+		{`{"min_price": 10, "max_price": 9007199254740993, "copies": 9007199254740993}`, ""},
+		{`{"min_price": 10.5, "max_price": 10, "copies": 10}`, map_validator.CodeGtField},
+		{`{"min_price": 1, "max_price": 9007199254740993, "copies": 9007199254740992}`, map_validator.CodeEqField},
+	}
+	for _, tc := range testCases {
+		req := httptest.NewRequest("POST", "/test", bytes.NewBufferString(tc.body))
+		check, err := map_validator.NewValidateBuilder().UseNumber().SetRules(rules).LoadJsonHttp(req)
+		if err != nil {
+			t.Fatalf("Expected not have error, but got error : %s", err)
+		}
+		_, err = check.RunValidate()
+		var fieldErr *map_validator.FieldError
+		if tc.code == "" && err != nil {
+			t.Errorf("Expected %s to pass, but got error : %s", tc.body, err)
+		}
+		if tc.code != "" && (!errors.As(err, &fieldErr) || fieldErr.Code != tc.code) {
+			t.Errorf("Expected %s error for %s, but got %v", tc.code, tc.body, err)
+		}
+	}
+}
+
+func TestCompareFieldsEqualStringsExactly(t *testing.T) {
+	testCases := []struct {
+		name     string
+		password string
+		confirm  string
+		code     string
+	}{
+		{"same", "2024-01-01", "2024-01-01", ""},
+		{"same date other layout", "2024-01-01", "2024-01-01 00:00:00", map_validator.CodeEqField},
+		{"same instant other zone", "2024-03-01T08:00:00+07:00", "2024-03-01T01:00:00Z", map_validator.CodeEqField},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rules := map_validator.BuildRoles().
+				SetRule("password", map_validator.Str()).
+				SetRule("password_confirmation", map_validator.Str().EqField("password")).
+				SetRule("new_password", map_validator.Str().NeField("password")).
+				SetSetting(map_validator.BuildSetting().MakeAllErrors().Done()).
+				Done()
+			payload := map[string]interface{}{"password": tc.password, "password_confirmation": tc.confirm, "new_password": tc.confirm}
+			check, err := map_validator.NewValidateBuilder().SetRules(rules).Load(payload)
+			if err != nil {
+				t.Fatalf("Expected not have error, but got error : %s", err)
+			}
+			_, err = check.RunValidate()
+			var fieldErr *map_validator.FieldError
+			if tc.code == "" {
+				// equal strings only fail the ne rule
+				if !errors.As(err, &fieldErr) || fieldErr.Code != map_validator.CodeNeField {
+					t.Errorf("Expected only ne_field error, but got %v", err)
+				}
+				return
+			}
+			if !errors.As(err, &fieldErr) || fieldErr.Code != tc.code || err.Error() != "the field 'password_confirmation' should be equal to 'password'" {
+				t.Errorf("Expected %s error only, but got %v", tc.code, err)
+			}
+		})
+	}
+}
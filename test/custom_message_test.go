@@ -256,3 +256,100 @@ func TestInvalidLengthMessageCaseOneT(t *testing.T) {
 		t.Errorf("Expected %s, but got error : %s", expected, err)
 	}
 }
+
+func TestCustomMessageForRequiredAndFormats(t *testing.T) {
+	testCases := []struct {
+		name     string
+		rule     map_validator.Rules
+		payload  map[string]interface{}
+		expected string
+	}{
+		{"null", map_validator.Str().WithMsg(map_validator.CustomMsg{
+			OnNull: map_validator.SetMessage("${field} is mandatory"),
+		}), map[string]interface{}{}, "target is mandatory"},
+		{"email", map_validator.Email().WithMsg(map_validator.CustomMsg{
+			OnEmail: map_validator.SetMessage("'${actual_value}' is not an email"),
+		}), map[string]interface{}{"target": "abc"}, "'abc' is not an email"},
+		{"uuid", map_validator.UUID().WithMsg(map_validator.CustomMsg{
+			OnUUID: map_validator.SetMessage("${field} needs uuid"),
+		}), map[string]interface{}{"target": "abc"}, "target needs uuid"},
+		{"ipv4", map_validator.IPv4().WithMsg(map_validator.CustomMsg{
+			OnIPV4: map_validator.SetMessage("bad ip ${actual_value}"),
+		}), map[string]interface{}{"target": "300.1.1.1"}, "bad ip 300.1.1.1"},
+		{"ipv4 network", map_validator.Rules{IPV4Network: true, CustomMsg: map_validator.CustomMsg{
+			OnIPV4Network: map_validator.SetMessage("bad network"),
+		}}, map[string]interface{}{"target": "10.0.0.1"}, "bad network"},
+		{"ipv4 optional prefix", map_validator.Rules{IPv4OptionalPrefix: true, CustomMsg: map_validator.CustomMsg{
+			OnIPv4OptionalPrefix: map_validator.SetMessage("bad cidr"),
+		}}, map[string]interface{}{"target": "10.0.0.1/40"}, "bad cidr"},
+		{"object", map_validator.Rules{AnonymousObject: true, CustomMsg: map_validator.CustomMsg{
+			OnObject: map_validator.SetMessage("${field} must be an object"),
+		}}, map[string]interface{}{"target": "x"}, "target must be an object"},
+		{"list", map_validator.List(map_validator.Str()).WithMsg(map_validator.CustomMsg{
+			OnList: map_validator.SetMessage("${field} must be a list"),
+		}), map[string]interface{}{"target": "x"}, "target must be a list"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rules := map_validator.BuildRoles().SetRule("target", tc.rule).Done()
+			check, err := map_validator.NewValidateBuilder().SetRules(rules).Load(tc.payload)
+			if err != nil {
+				t.Fatalf("Expected not have error, but got error : %s", err)
+			}
+			_, err = check.RunValidate()
+			if err == nil || err.Error() != tc.expected {
+				t.Errorf("Expected '%s', but we got '%v'", tc.expected, err)
+			}
+		})
+	}
+}
+
+func TestCustomMessageForRequiredWithoutAndRequiredIf(t *testing.T) {
+	rules := map_validator.BuildRoles().
+		SetRule("flavor", map_validator.Str().WithRequiredWithout("custom_flavor").WithMsg(map_validator.CustomMsg{
+			OnRequiredWithout: map_validator.SetMessage("fill ${field} or one of ${dependencies}"),
+		})).
+		SetRule("custom_flavor", map_validator.Str().Nullable()).
+		Done()
+	check, err := map_validator.NewValidateBuilder().SetRules(rules).Load(map[string]interface{}{})
+	if err != nil {
+		t.Fatalf("Expected not have error, but got error : %s", err)
+	}
+	_, err = check.RunValidate()
+	expected := "fill custom_flavor or one of [flavor]"
+	if err == nil || err.Error() != expected {
+		t.Errorf("Expected '%s', but we got '%v'", expected, err)
+	}
+
+	rules = map_validator.BuildRoles().
+		SetRule("size", map_validator.Int().Nullable()).
+		SetRule("unit", map_validator.Str().WithRequiredIf("size").WithMsg(map_validator.CustomMsg{
+			OnRequiredIf: map_validator.SetMessage("${dependencies} is needed when ${field} is sent"),
+		})).
+		Done()
+	check, err = map_validator.NewValidateBuilder().SetRules(rules).Load(map[string]interface{}{"size": 1})
+	if err != nil {
+		t.Fatalf("Expected not have error, but got error : %s", err)
+	}
+	_, err = check.RunValidate()
+	expected = "[unit] is needed when size is sent"
+	if err == nil || err.Error() != expected {
+		t.Errorf("Expected '%s', but we got '%v'", expected, err)
+	}
+}
+
+func TestCustomMessageForUnknownKey(t *testing.T) {
+	rules := map_validator.BuildRoles().
+		SetRule("name", map_validator.Str()).
+		SetSetting(map_validator.BuildSetting().MakeStrict().WithUnknownKeyMsg("${unknown_key} is not accepted").Done()).
+		Done()
+	check, err := map_validator.NewValidateBuilder().SetRules(rules).Load(map[string]interface{}{"name": "a", "role": "admin"})
+	if err != nil {
+		t.Fatalf("Expected not have error, but got error : %s", err)
+	}
+	_, err = check.RunValidate()
+	expected := "role is not accepted"
+	if err == nil || err.Error() != expected {
+		t.Errorf("Expected '%s', but we got '%v'", expected, err)
+	}
+}
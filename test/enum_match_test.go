@@ -0,0 +1,165 @@
+package test
+
+import (
+	"bytes"
+	"errors"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/Rhyanz46/go-map-validator/map_validator"
+)
+
+func TestEnumMatchOptions(t *testing.T) {
+	testCases := []struct {
+		name  string
+		rule  map_validator.Rules
+		value string
+		pass  bool
+	}{
+		{"case sensitive by default", map_validator.StrEnum("admin", "guest"), "ADMIN", false},
+		{"ignore case", map_validator.StrEnum("admin", "guest").EnumIgnoreCase(), "Admin", true},
+		{"spaces kept by default", map_validator.StrEnum("admin").EnumIgnoreCase(), " admin ", false},
+		{"trim space", map_validator.StrEnum("admin").EnumIgnoreCase().EnumTrimSpace(), " ADMIN ", true},
+		{"normalize", map_validator.StrEnum("super-admin").EnumNormalize(func(s string) string {
+			return strings.ReplaceAll(s, "_", "-")
+		}), "super_admin", true},
+		{"still rejects others", map_validator.StrEnum("admin").EnumIgnoreCase().EnumTrimSpace(), "root", false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rules := map_validator.BuildRoles().SetRule("role", tc.rule).Done()
+			check, err := map_validator.NewValidateBuilder().SetRules(rules).Load(map[string]interface{}{"role": tc.value})
+			if err != nil {
+				t.Fatalf("Expected not have error, but got error : %s", err)
+			}
+			_, err = check.RunValidate()
+			var fieldErr *map_validator.FieldError
+			if tc.pass && err != nil {
+				t.Errorf("Expected not have error, but got error : %s", err)
+			}
+			if !tc.pass && (!errors.As(err, &fieldErr) || fieldErr.Code != map_validator.CodeEnum) {
+				t.Errorf("Expected enum error, but got %v", err)
+			}
+		})
+	}
+}
+
+func TestEnumCanonicalBind(t *testing.T) {
+	rules := map_validator.BuildRoles().
+		SetRule("role", map_validator.StrEnum("admin", "guest").EnumIgnoreCase().EnumTrimSpace().EnumCanonical()).
+		SetRule("scopes", map_validator.List(map_validator.StrEnum("read", "write").EnumIgnoreCase().EnumCanonical())).
+		SetRule("plan", map_validator.StrEnum("Free", "Pro").EnumIgnoreCase()).
+		Done()
+	body := `{"role": " ADMIN ", "scopes": ["READ", "Write"], "plan": "pro"}`
+	req := httptest.NewRequest("POST", "/test", bytes.NewBufferString(body))
+	got, err := map_validator.ValidateJSON[struct {
+		Role   string   `json:"role"`
+		Scopes []string `json:"scopes"`
+		Plan   string   `json:"plan"`
+	}](req, rules)
+	if err != nil {
+		t.Fatalf("Expected not have error, but got error : %s", err)
+	}
+	if got.Role != "admin" || strings.Join(got.Scopes, ",") != "read,write" {
+		t.Errorf("Expected canonical values, but got %+v", got)
+	}
+	if got.Plan != "pro" {
+		t.Errorf("Expected input kept without EnumCanonical, but got %s", got.Plan)
+	}
+}
+
+type orderStatus string
+
+const (
+	orderPending orderStatus = "pending"
+	orderPaid    orderStatus = "paid"
+)
+
+type priority uint8
+
+func TestEnumEveryKind(t *testing.T) {
+	testCases := []struct {
+		name string
+		rule map_validator.Rules
+		pass string
+		fail string
+	}{
+		{"typed string", map_validator.EnumOf(orderPending, orderPaid), `"paid"`, `"void"`},
+		{"bool", map_validator.EnumOf(true), `true`, `false`},
+		{"float32", map_validator.EnumOf[float32](0.5, 1.5), `1.5`, `2.5`},
+		{"float64", map_validator.EnumOf(0.1, 0.2), `0.1`, `0.3`},
+		{"typed uint", map_validator.EnumOf[priority](1, 2, 3), `2`, `4`},
+		{"int16", map_validator.EnumOf[int16](-1, 1), `-1`, `0`},
+		{"list element", map_validator.List(map_validator.EnumOf(orderPending, orderPaid)), `["paid", "pending"]`, `["paid", "void"]`},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rules := map_validator.BuildRoles().SetRule("v", tc.rule).Done()
+			for _, body := range []string{tc.pass, tc.fail} {
+				req := httptest.NewRequest("POST", "/test", bytes.NewBufferString(`{"v": `+body+`}`))
+				check, err := map_validator.NewValidateBuilder().SetRules(rules).LoadJsonHttp(req)
+				if err != nil {
+					t.Fatalf("Expected not have error, but got error : %s", err)
+				}
+				_, err = check.RunValidate()
+				if body == tc.pass && err != nil {
+					t.Errorf("Expected %s to pass, but got error : %s", body, err)
+				}
+				var fieldErr *map_validator.FieldError
+				if body == tc.fail && (!errors.As(err, &fieldErr) || fieldErr.Code != map_validator.CodeEnum) {
+					t.Errorf("Expected %s to fail with enum error, but got %v", body, err)
+				}
+			}
+		})
+	}
+}
+
+func TestEnumOfBindsTypedValue(t *testing.T) {
+	rules := map_validator.BuildRoles().
+		SetRule("status", map_validator.EnumOf(orderPending, orderPaid)).
+		SetRule("priority", map_validator.EnumOf[priority](1, 2, 3)).
+		Done()
+	req := httptest.NewRequest("POST", "/test", bytes.NewBufferString(`{"status": "paid", "priority": 3}`))
+	got, err := map_validator.ValidateJSON[struct {
+		Status   orderStatus `json:"status"`
+		Priority priority    `json:"priority"`
+	}](req, rules)
+	if err != nil {
+		t.Fatalf("Expected not have error, but got error : %s", err)
+	}
+	if got.Status != orderPaid || got.Priority != 3 {
+		t.Errorf("Expected paid/3, but got %+v", got)
+	}
+}
+
+func TestEnumMetadata(t *testing.T) {
+	line := map_validator.BuildRoles().SetRule("kind", map_validator.StrEnum("digital", "physical"))
+	rules := map_validator.BuildRoles().
+		SetRule("status", map_validator.EnumOf(orderPending, orderPaid).EnumIgnoreCase()).
+		SetRule("tags", map_validator.List(map_validator.StrEnum("new", "sale"))).
+		SetRule("lines", map_validator.ListOfObject(line)).
+		SetRule("name", map_validator.Str()).
+		Done()
+	enums := map_validator.Enums(rules)
+	if len(enums) != 3 {
+		t.Fatalf("Expected 3 enums, but got %v", enums)
+	}
+	status := enums["status"]
+	if status.Kind != reflect.String || status.Type != reflect.TypeOf(orderPaid) || !status.CaseInsensitive {
+		t.Errorf("Expected typed string enum, but got %+v", status)
+	}
+	if len(status.Values) != 2 || status.Values[1] != orderPaid {
+		t.Errorf("Expected declared values, but got %v", status.Values)
+	}
+	if _, ok := enums["tags[]"]; !ok {
+		t.Errorf("Expected list element enum, but got %v", enums)
+	}
+	if kind := enums["lines[].kind"]; len(kind.Values) != 2 || kind.Values[0] != "digital" {
+		t.Errorf("Expected nested enum, but got %v", enums)
+	}
+	if _, ok := map_validator.Str().EnumInfo(); ok {
+		t.Error("Expected no enum on Str()")
+	}
+}
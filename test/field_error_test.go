@@ -0,0 +1,126 @@
+package test
+
+import (
+	"bytes"
+	"errors"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Rhyanz46/go-map-validator/map_validator"
+)
+
+func TestFieldErrorFromRunValidate(t *testing.T) {
+	rules := map_validator.BuildRoles().
+		SetRule("name", map_validator.Str().WithMax(3)).
+		Done()
+	check, err := map_validator.NewValidateBuilder().SetRules(rules).Load(map[string]interface{}{"name": "abcdef"})
+	if err != nil {
+		t.Fatalf("Expected not have error, but got error : %s", err)
+	}
+	_, err = check.RunValidate()
+	var fieldErr *map_validator.FieldError
+	if !errors.As(err, &fieldErr) {
+		t.Fatalf("Expected FieldError, but got %T", err)
+	}
+	if fieldErr.Field != "name" || fieldErr.Code != map_validator.CodeMax {
+		t.Errorf("Expected name/max, but got %s/%s", fieldErr.Field, fieldErr.Code)
+	}
+	if fieldErr.Expected != int64(3) || fieldErr.Actual != int64(6) {
+		t.Errorf("Expected 3/6, but got %v/%v", fieldErr.Expected, fieldErr.Actual)
+	}
+	if err.Error() != "the field 'name' should be or lower than 3" {
+		t.Errorf("Expected message to stay the same, but got %s", err)
+	}
+}
+
+func TestFieldErrorCodes(t *testing.T) {
+	child := map_validator.BuildRoles().
+		SetRule("flavor", map_validator.Str().WithRequiredWithout("custom_flavor")).
+		SetRule("custom_flavor", map_validator.Str().WithRequiredWithout("flavor"))
+	testCases := []struct {
+		name    string
+		rules   map_validator.RulesWrapper
+		payload map[string]interface{}
+		code    string
+	}{
+		{"required", map_validator.BuildRoles().SetRule("a", map_validator.Str()), map[string]interface{}{}, map_validator.CodeRequired},
+		{"type", map_validator.BuildRoles().SetRule("a", map_validator.Str()), map[string]interface{}{"a": 1}, map_validator.CodeType},
+		{"enum", map_validator.BuildRoles().SetRule("a", map_validator.StrEnum("x")), map[string]interface{}{"a": "y"}, map_validator.CodeEnum},
+		{"uuid", map_validator.BuildRoles().SetRule("a", map_validator.UUID()), map[string]interface{}{"a": "y"}, map_validator.CodeUUID},
+		{"regex", map_validator.BuildRoles().SetRule("a", map_validator.Str().Regex(`^\d+$`)), map[string]interface{}{"a": "y"}, map_validator.CodeRegex},
+		{"unique", map_validator.BuildRoles().
+			SetRule("a", map_validator.Str()).
+			SetRule("b", map_validator.Str().UniqueFrom("a")), map[string]interface{}{"a": "y", "b": "y"}, map_validator.CodeUnique},
+		{"strict", map_validator.BuildRoles().
+			SetRule("a", map_validator.Str()).
+			SetSetting(map_validator.Setting{Strict: true}), map[string]interface{}{"a": "y", "b": "y"}, map_validator.CodeStrictUnknownKey},
+		{"required_without", map_validator.BuildRoles().SetRule("data", map_validator.NestedObject(child)),
+			map[string]interface{}{"data": map[string]interface{}{}}, map_validator.CodeRequiredWithout},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			check, err := map_validator.NewValidateBuilder().SetRules(tc.rules).Load(tc.payload)
+			if err != nil {
+				t.Fatalf("Expected not have error, but got error : %s", err)
+			}
+			_, err = check.RunValidate()
+			var fieldErr *map_validator.FieldError
+			if !errors.As(err, &fieldErr) {
+				t.Fatalf("Expected FieldError, but got %v", err)
+			}
+			if fieldErr.Code != tc.code {
+				t.Errorf("Expected code %s, but got %s (%s)", tc.code, fieldErr.Code, err)
+			}
+		})
+	}
+}
+
+func TestFieldErrorFromValidateJSON(t *testing.T) {
+	req := httptest.NewRequest("POST", "/test", bytes.NewBufferString(`{"status": "banned"}`))
+	rules := map_validator.BuildRoles().
+		SetRule("status", map_validator.StrEnum("active", "inactive")).
+		Done()
+
+	_, err := map_validator.ValidateJSON[map[string]interface{}](req, rules)
+	var fieldErr *map_validator.FieldError
+	if !errors.As(err, &fieldErr) {
+		t.Fatalf("Expected FieldError, but got %v", err)
+	}
+	if fieldErr.Code != map_validator.CodeEnum || fieldErr.Actual != "banned" {
+		t.Errorf("Expected enum failure with actual 'banned', but got %s/%v", fieldErr.Code, fieldErr.Actual)
+	}
+	if fieldErr.Params["enum_values"] != "[active inactive]" {
+		t.Errorf("Expected enum_values param, but got %v", fieldErr.Params)
+	}
+}
+
+func TestFieldErrorFromValidateValue(t *testing.T) {
+	_, err := map_validator.ValidateValue(nil, map_validator.Str(), map_validator.FromMapString)
+	var fieldErr *map_validator.FieldError
+	if !errors.As(err, &fieldErr) {
+		t.Fatalf("Expected FieldError, but got %v", err)
+	}
+	if fieldErr.Code != map_validator.CodeRequired || err.Error() != "value is required" {
+		t.Errorf("Expected required failure, but got %s/%s", fieldErr.Code, err)
+	}
+}
+
+func TestFieldErrorInsideValidationErrors(t *testing.T) {
+	rules := map_validator.BuildRoles().
+		SetRule("a", map_validator.Email()).
+		SetRule("b", map_validator.UUID()).
+		Done()
+	check, err := map_validator.NewValidateBuilder().SetRules(rules).Load(map[string]interface{}{"a": "x", "b": "y"})
+	if err != nil {
+		t.Fatalf("Expected not have error, but got error : %s", err)
+	}
+	_, err = check.RunValidateAll()
+	var validationErrs map_validator.ValidationErrors
+	if !errors.As(err, &validationErrs) {
+		t.Fatalf("Expected ValidationErrors, but got %v", err)
+	}
+	fieldErrs := validationErrs.FieldErrors()
+	if len(fieldErrs) != 2 || fieldErrs[0].Code != map_validator.CodeEmail || fieldErrs[1].Code != map_validator.CodeUUID {
+		t.Errorf("Expected email and uuid failures, but got %v", fieldErrs)
+	}
+}
@@ -0,0 +1,105 @@
+package test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Rhyanz46/go-map-validator/map_validator"
+)
+
+func runValidateFieldError(t *testing.T, rules map_validator.RulesWrapper, payload map[string]interface{}) *map_validator.FieldError {
+	t.Helper()
+	check, err := map_validator.NewValidateBuilder().SetRules(rules).Load(payload)
+	if err != nil {
+		t.Fatalf("Expected not have error, but got error : %s", err)
+	}
+	_, err = check.RunValidate()
+	var fieldErr *map_validator.FieldError
+	if !errors.As(err, &fieldErr) {
+		t.Fatalf("Expected FieldError, but got %v", err)
+	}
+	return fieldErr
+}
+
+func TestFieldPathNestedObject(t *testing.T) {
+	address := map_validator.BuildRoles().SetRule("city", map_validator.Str().WithMin(3))
+	rules := map_validator.BuildRoles().SetRule("address", map_validator.NestedObject(address)).Done()
+
+	fieldErr := runValidateFieldError(t, rules, map[string]interface{}{
+		"address": map[string]interface{}{"city": "x"},
+	})
+	if fieldErr.Path != "address.city" || fieldErr.Pointer != "/address/city" {
+		t.Errorf("Expected address.city and /address/city, but got %s and %s", fieldErr.Path, fieldErr.Pointer)
+	}
+	if fieldErr.Error() != "the field 'city' should be or greater than 3" {
+		t.Errorf("Expected message to stay the same, but got %s", fieldErr)
+	}
+}
+
+func TestFieldPathListOfObject(t *testing.T) {
+	item := map_validator.BuildRoles().SetRule("sku", map_validator.Str())
+	rules := map_validator.BuildRoles().SetRule("items", map_validator.ListOfObject(item)).Done()
+
+	fieldErr := runValidateFieldError(t, rules, map[string]interface{}{
+		"items": []interface{}{
+			map[string]interface{}{"sku": "a"},
+			map[string]interface{}{"sku": "b"},
+			map[string]interface{}{"sku": "c"},
+			map[string]interface{}{"sku": 4},
+		},
+	})
+	if fieldErr.Path != "items[3].sku" || fieldErr.Pointer != "/items/3/sku" {
+		t.Errorf("Expected items[3].sku and /items/3/sku, but got %s and %s", fieldErr.Path, fieldErr.Pointer)
+	}
+}
+
+func TestFieldPathPrimitiveList(t *testing.T) {
+	rules := map_validator.BuildRoles().SetRule("tags", map_validator.List(map_validator.Str().WithMax(3))).Done()
+
+	fieldErr := runValidateFieldError(t, rules, map[string]interface{}{
+		"tags": []interface{}{"a", "b", "too long"},
+	})
+	if fieldErr.Path != "tags[2]" || fieldErr.Pointer != "/tags/2" {
+		t.Errorf("Expected tags[2] and /tags/2, but got %s and %s", fieldErr.Path, fieldErr.Pointer)
+	}
+}
+
+func TestFieldPathCustomMessage(t *testing.T) {
+	item := map_validator.BuildRoles().SetRule("qty", map_validator.Int().WithMin(1).WithMsg(map_validator.CustomMsg{
+		OnMin: map_validator.SetMessage("${field_path} must be at least ${expected_min_length}"),
+	}))
+	order := map_validator.BuildRoles().SetRule("items", map_validator.ListOfObject(item))
+	rules := map_validator.BuildRoles().SetRule("order", map_validator.NestedObject(order)).Done()
+
+	fieldErr := runValidateFieldError(t, rules, map[string]interface{}{
+		"order": map[string]interface{}{
+			"items": []interface{}{map[string]interface{}{"qty": 0}},
+		},
+	})
+	expected := "order.items[0].qty must be at least 1"
+	if fieldErr.Error() != expected {
+		t.Errorf("Expected %s, but got %s", expected, fieldErr)
+	}
+}
+
+func TestFieldPathPointerEscaping(t *testing.T) {
+	rules := map_validator.BuildRoles().SetRule("a/b~c", map_validator.Str()).Done()
+	fieldErr := runValidateFieldError(t, rules, map[string]interface{}{})
+	if fieldErr.Pointer != "/a~1b~0c" {
+		t.Errorf("Expected /a~1b~0c, but got %s", fieldErr.Pointer)
+	}
+}
+
+func TestFieldPathUniqueInNested(t *testing.T) {
+	child := map_validator.BuildRoles().
+		SetRule("password", map_validator.Str()).
+		SetRule("new_password", map_validator.Str().UniqueFrom("password"))
+	rules := map_validator.BuildRoles().SetRule("data", map_validator.NestedObject(child)).Done()
+
+	fieldErr := runValidateFieldError(t, rules, map[string]interface{}{
+		"data": map[string]interface{}{"password": "x", "new_password": "x"},
+	})
+	if fieldErr.Path != "data.new_password" {
+		t.Errorf("Expected data.new_password, but got %s", fieldErr.Path)
+	}
+}
@@ -0,0 +1,245 @@
+package test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Rhyanz46/go-map-validator/map_validator"
+)
+
+type uploadFile struct {
+	name    string
+	content []byte
+}
+
+func newMultipartRequest(t *testing.T, fields map[string]string, files map[string][]uploadFile) *http.Request {
+	t.Helper()
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	for key, value := range fields {
+		if err := writer.WriteField(key, value); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for key, list := range files {
+		for _, f := range list {
+			part, err := writer.CreateFormFile(key, f.name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := part.Write(f.content); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	if err := writer.Close(); err != nil {
+		t.Fatal(err)
+	}
+	req := httptest.NewRequest("POST", "/upload", body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	return req
+}
+
+type galleryUpload struct {
+	Title  string                      `json:"title"`
+	Cover  map_validator.FileRequest   `json:"cover"`
+	Photos []map_validator.FileRequest `json:"photos"`
+}
+
+func TestLoadFormHttpFileList(t *testing.T) {
+	rules := map_validator.BuildRoles().
+		SetRule("title", map_validator.Str()).
+		SetRule("cover", map_validator.File()).
+		SetRule("photos", map_validator.List(map_validator.File()).Between(1, 2)).
+		Done()
+	req := newMultipartRequest(t, map[string]string{"title": "trip"}, map[string][]uploadFile{
+		"cover":  {{"cover.txt", []byte("cover")}},
+		"photos": {{"a.txt", []byte("aaa")}, {"b.txt", []byte("bbb")}},
+	})
+	check, err := map_validator.NewValidateBuilder().SetRules(rules).LoadFormHttp(req)
+	if err != nil {
+		t.Fatalf("Expected not have error, but got error : %s", err)
+	}
+	res, err := check.RunValidate()
+	if err != nil {
+		t.Fatalf("Expected not have error, but got error : %s", err)
+	}
+	var got galleryUpload
+	if err := res.Bind(&got); err != nil {
+		t.Fatalf("Expected not have error, but got error : %s", err)
+	}
+	if got.Title != "trip" || got.Cover.FileInfo == nil || got.Cover.FileInfo.Filename != "cover.txt" {
+		t.Errorf("Expected title and cover, but got %+v", got)
+	}
+	if len(got.Photos) != 2 || got.Photos[1].FileInfo.Filename != "b.txt" {
+		t.Fatalf("Expected 2 photos, but got %+v", got.Photos)
+	}
+	content, _ := io.ReadAll(got.Photos[1].File)
+	if string(content) != "bbb" {
+		t.Errorf("Expected second photo content, but got %s", content)
+	}
+}
+
+func TestLoadFormHttpFileListCount(t *testing.T) {
+	rules := map_validator.BuildRoles().
+		SetRule("title", map_validator.Str()).
+		SetRule("cover", map_validator.File()).
+		SetRule("photos", map_validator.List(map_validator.File()).Between(1, 2)).
+		Done()
+	testCases := []struct {
+		name   string
+		photos []uploadFile
+		code   string
+	}{
+		{"no files", nil, map_validator.CodeRequired},
+		{"too many files", []uploadFile{{"a.txt", []byte("a")}, {"b.txt", []byte("b")}, {"c.txt", []byte("c")}}, map_validator.CodeMax},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			files := map[string][]uploadFile{"cover": {{"cover.txt", []byte("cover")}}}
+			if tc.photos != nil {
+				files["photos"] = tc.photos
+			}
+			req := newMultipartRequest(t, map[string]string{"title": "trip"}, files)
+			check, err := map_validator.NewValidateBuilder().SetRules(rules).LoadFormHttp(req)
+			if err != nil {
+				t.Fatalf("Expected not have error, but got error : %s", err)
+			}
+			_, err = check.RunValidate()
+			var fieldErr *map_validator.FieldError
+			if !errors.As(err, &fieldErr) || fieldErr.Field != "photos" || fieldErr.Code != tc.code {
+				t.Errorf("Expected %s error on photos, but got %v", tc.code, err)
+			}
+		})
+	}
+}
+
+var pngHeader = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+func TestFileRulesChecks(t *testing.T) {
+	testCases := []struct {
+		name string
+		rule map_validator.Rules
+		file uploadFile
+		code string
+	}{
+		{"too small", map_validator.File().MinSize(10), uploadFile{"a.txt", []byte("abc")}, map_validator.CodeFileMinSize},
+		{"too big", map_validator.File().MaxSize(2), uploadFile{"a.txt", []byte("abc")}, map_validator.CodeFileMaxSize},
+		{"sniffed type", map_validator.File().AllowTypes("image/*"), uploadFile{"fake.png", []byte("plain text")}, map_validator.CodeFileType},
+		{"extension not allowed", map_validator.File().AllowExtensions("png", ".JPG"), uploadFile{"a.gif", pngHeader}, map_validator.CodeFileExtension},
+		{"extension denied", map_validator.File().DenyExtensions(".exe"), uploadFile{"setup.EXE", []byte("MZ")}, map_validator.CodeFileExtension},
+		{"list item", map_validator.List(map_validator.File().MaxSize(2)), uploadFile{"a.txt", []byte("abc")}, map_validator.CodeFileMaxSize},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rules := map_validator.BuildRoles().SetRule("upload", tc.rule).Done()
+			req := newMultipartRequest(t, nil, map[string][]uploadFile{"upload": {tc.file}})
+			check, err := map_validator.NewValidateBuilder().SetRules(rules).LoadFormHttp(req)
+			if err != nil {
+				t.Fatalf("Expected not have error, but got error : %s", err)
+			}
+			_, err = check.RunValidate()
+			var fieldErr *map_validator.FieldError
+			if !errors.As(err, &fieldErr) || fieldErr.Code != tc.code {
+				t.Errorf("Expected %s error, but got %v", tc.code, err)
+			}
+		})
+	}
+}
+
+func TestFileRulesPassAndSanitize(t *testing.T) {
+	rules := map_validator.BuildRoles().
+		SetRule("avatar", map_validator.File().MaxSize(1024).AllowTypes("image/png").AllowExtensions("png").SanitizeName()).
+		Done()
+	req := newMultipartRequest(t, nil, map[string][]uploadFile{"avatar": {{"my avatar;rm -rf.png", pngHeader}}})
+	check, err := map_validator.NewValidateBuilder().SetRules(rules).LoadFormHttp(req)
+	if err != nil {
+		t.Fatalf("Expected not have error, but got error : %s", err)
+	}
+	res, err := check.RunValidate()
+	if err != nil {
+		t.Fatalf("Expected not have error, but got error : %s", err)
+	}
+	var got struct {
+		Avatar map_validator.FileRequest `json:"avatar"`
+	}
+	if err := res.Bind(&got); err != nil {
+		t.Fatalf("Expected not have error, but got error : %s", err)
+	}
+	if got.Avatar.FileInfo.Filename != "my_avatar_rm_-rf.png" {
+		t.Errorf("Expected sanitized name, but got %s", got.Avatar.FileInfo.Filename)
+	}
+	if name := req.MultipartForm.File["avatar"][0].Filename; name != "my avatar;rm -rf.png" {
+		t.Errorf("Expected the request's file name to be kept, but got %s", name)
+	}
+	content, _ := io.ReadAll(got.Avatar.File)
+	if !bytes.Equal(content, pngHeader) {
+		t.Errorf("Expected file to be readable from the start, but got %q", content)
+	}
+}
+
+func TestFileRulesDeniedExtension(t *testing.T) {
+	rules := map_validator.BuildRoles().
+		SetRule("setup", map_validator.File().DenyExtensions("exe", "sh")).
+		SetRule("script", map_validator.File().DenyExtensions("exe", "sh").WithMsg(map_validator.CustomMsg{
+			OnFileExtension: map_validator.SetMessage("${field}: .${extension} is one of ${denied_extensions}"),
+		})).
+		SetSetting(map_validator.BuildSetting().MakeAllErrors().Done()).
+		Done()
+	req := newMultipartRequest(t, nil, map[string][]uploadFile{
+		"setup":  {{"setup.EXE", []byte("MZ")}},
+		"script": {{"run.sh", []byte("#!/bin/sh")}},
+	})
+	check, err := map_validator.NewValidateBuilder().SetRules(rules).LoadFormHttp(req)
+	if err != nil {
+		t.Fatalf("Expected not have error, but got error : %s", err)
+	}
+	_, err = check.RunValidate()
+	var errs map_validator.ValidationErrors
+	if !errors.As(err, &errs) {
+		t.Fatalf("Expected ValidationErrors, but got %v", err)
+	}
+	messages := map[string]string{}
+	for _, fieldErr := range errs.FieldErrors() {
+		messages[fieldErr.Field] = fieldErr.Message
+		if fieldErr.Code != map_validator.CodeFileExtension || fieldErr.Expected != "[exe sh]" {
+			t.Errorf("Expected file_extension error expecting [exe sh], but got %s %v", fieldErr.Code, fieldErr.Expected)
+		}
+	}
+	if messages["setup"] != "the field 'setup' has extension 'exe' which is denied" {
+		t.Errorf("Expected denied message, but got %s", messages["setup"])
+	}
+	if messages["script"] != "script: .sh is one of [exe sh]" {
+		t.Errorf("Expected custom message, but got %s", messages["script"])
+	}
+}
+
+func TestFileRulesCustomMessage(t *testing.T) {
+	rules := map_validator.BuildRoles().
+		SetRule("doc", map_validator.File().MaxSize(2).WithMsg(map_validator.CustomMsg{
+			OnFileMaxSize: map_validator.SetMessage("${field} is ${actual_size} bytes, max ${expected_max_length}"),
+		})).
+		SetRule("pic", map_validator.File().AllowTypes("image/png").WithMsg(map_validator.CustomMsg{
+			OnFileType: map_validator.SetMessage("${mime_type} not in ${allowed_types}"),
+		}).Nullable()).
+		SetSetting(map_validator.BuildSetting().MakeAllErrors().Done()).
+		Done()
+	req := newMultipartRequest(t, nil, map[string][]uploadFile{
+		"doc": {{"a.txt", []byte("abc")}},
+		"pic": {{"a.png", []byte("text")}},
+	})
+	check, err := map_validator.NewValidateBuilder().SetRules(rules).LoadFormHttp(req)
+	if err != nil {
+		t.Fatalf("Expected not have error, but got error : %s", err)
+	}
+	_, err = check.RunValidate()
+	expected := "doc is 3 bytes, max 2; text/plain not in [image/png]"
+	if err == nil || err.Error() != expected {
+		t.Errorf("Expected '%s', but got %v", expected, err)
+	}
+}
@@ -0,0 +1,134 @@
+package test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/Rhyanz46/go-map-validator/map_validator"
+)
+
+func newFormRequest(values url.Values) *http.Request {
+	req := httptest.NewRequest("POST", "/test", strings.NewReader(values.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	return req
+}
+
+func TestLoadFormHttpCoercesTypes(t *testing.T) {
+	rules := map_validator.BuildRoles().
+		SetRule("age", map_validator.Int().Between(18, 99)).
+		SetRule("level", map_validator.Rules{Type: reflect.Uint8}).
+		SetRule("score", map_validator.Float64()).
+		SetRule("active", map_validator.Bool()).
+		SetRule("id", map_validator.UUID()).
+		SetRule("role", map_validator.IntEnum(1, 2)).
+		SetRule("page", map_validator.Int().Nullable().Default(1)).
+		Done()
+	req := newFormRequest(url.Values{
+		"age":    {"30"},
+		"level":  {"7"},
+		"score":  {"9.5"},
+		"active": {"on"},
+		"id":     {"9d2c4b6e-4d0c-4a38-8a3e-1b2f3c4d5e6f"},
+		"role":   {"2"},
+	})
+
+	check, err := map_validator.NewValidateBuilder().SetRules(rules).LoadFormHttp(req)
+	if err != nil {
+		t.Fatalf("Expected not have error, but got error : %s", err)
+	}
+	res, err := check.RunValidate()
+	if err != nil {
+		t.Fatalf("Expected not have error, but got error : %s", err)
+	}
+	data := res.GetData()
+	if data["age"] != 30 || data["level"] != uint8(7) || data["score"] != 9.5 || data["active"] != true || data["role"] != 2 {
+		t.Errorf("Expected coerced values, but got %v", data)
+	}
+	if data["page"] != 1 {
+		t.Errorf("Expected default page 1, but got %v", data["page"])
+	}
+}
+
+func TestLoadFormHttpCoercionFailures(t *testing.T) {
+	testCases := []struct {
+		name  string
+		rule  map_validator.Rules
+		value string
+		code  string
+	}{
+		{"not a number", map_validator.Int(), "abc", map_validator.CodeType},
+		{"overflow", map_validator.Rules{Type: reflect.Int8}, "300", map_validator.CodeRange},
+		{"bad bool", map_validator.Bool(), "yes", map_validator.CodeType},
+		{"below min", map_validator.Int().WithMin(10), "3", map_validator.CodeMin},
+		{"not in enum", map_validator.IntEnum(1, 2), "3", map_validator.CodeEnum},
+		{"bad uuid", map_validator.UUID(), "x", map_validator.CodeUUID},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rules := map_validator.BuildRoles().SetRule("field", tc.rule).Done()
+			check, err := map_validator.NewValidateBuilder().SetRules(rules).LoadFormHttp(newFormRequest(url.Values{"field": {tc.value}}))
+			if err != nil {
+				t.Fatalf("Expected not have error, but got error : %s", err)
+			}
+			_, err = check.RunValidate()
+			var fieldErr *map_validator.FieldError
+			if !errors.As(err, &fieldErr) || fieldErr.Code != tc.code || fieldErr.Field != "field" {
+				t.Errorf("Expected %s error on field, but got %v", tc.code, err)
+			}
+		})
+	}
+}
+
+func TestCoercedNumbersOutOfRange(t *testing.T) {
+	testCases := []struct {
+		name  string
+		rule  map_validator.Rules
+		value string
+		json  string
+	}{
+		{"int8 overflow", map_validator.Rules{Type: reflect.Int8}, "300", "300"},
+		{"int64 overflow", map_validator.Int64(), "9223372036854775808", "9223372036854775808"},
+		{"uint negative", map_validator.Rules{Type: reflect.Uint}, "-1", "-1"},
+		{"list element", map_validator.List(map_validator.Rules{Type: reflect.Int8}), "300", "[300]"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rules := map_validator.BuildRoles().SetRule("v", tc.rule).Done()
+			var errs []error
+			form, err := map_validator.NewValidateBuilder().SetRules(rules).LoadFormHttp(newFormRequest(url.Values{"v": {tc.value}}))
+			if err != nil {
+				t.Fatalf("Expected not have error, but got error : %s", err)
+			}
+			query, err := map_validator.NewValidateBuilder().SetRules(rules).LoadQueryHttp(httptest.NewRequest("GET", "/test?v="+url.QueryEscape(tc.value), nil))
+			if err != nil {
+				t.Fatalf("Expected not have error, but got error : %s", err)
+			}
+			body, err := map_validator.NewValidateBuilder().UseNumber().SetRules(rules).LoadJsonHttp(httptest.NewRequest("POST", "/test", strings.NewReader(`{"v": `+tc.json+`}`)))
+			if err != nil {
+				t.Fatalf("Expected not have error, but got error : %s", err)
+			}
+			for _, check := range []interface {
+				RunValidate() (*map_validator.ExtraOperationData, error)
+			}{form, query, body} {
+				_, err := check.RunValidate()
+				errs = append(errs, err)
+			}
+			var expected *map_validator.FieldError
+			if !errors.As(errs[2], &expected) || expected.Code != map_validator.CodeRange {
+				t.Fatalf("Expected range error from JSON, but got %v", errs[2])
+			}
+			for _, err := range errs[:2] {
+				var fieldErr *map_validator.FieldError
+				if !errors.As(err, &fieldErr) || fieldErr.Code != map_validator.CodeRange ||
+					fieldErr.Message != expected.Message || !reflect.DeepEqual(fieldErr.Params, expected.Params) {
+					t.Errorf("Expected '%s' (%v), but got %v", expected.Message, expected.Params, err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,126 @@
+package test
+
+import (
+	"errors"
+	"net/url"
+	"testing"
+
+	"github.com/Rhyanz46/go-map-validator/map_validator"
+)
+
+type formOrder struct {
+	User struct {
+		Name string `json:"name"`
+	} `json:"user"`
+	Address struct {
+		City string `json:"city"`
+	} `json:"address"`
+	Items []struct {
+		SKU string `json:"sku"`
+		Qty int    `json:"qty"`
+	} `json:"items"`
+	Tags []string `json:"tags"`
+}
+
+func TestLoadFormHttpNestedNotation(t *testing.T) {
+	user := map_validator.BuildRoles().SetRule("name", map_validator.Str().WithMin(2))
+	address := map_validator.BuildRoles().SetRule("city", map_validator.Str())
+	item := map_validator.BuildRoles().
+		SetRule("sku", map_validator.Str()).
+		SetRule("qty", map_validator.Int().WithMin(1))
+	rules := map_validator.BuildRoles().
+		SetRule("user", map_validator.NestedObject(user)).
+		SetRule("address", map_validator.NestedObject(address)).
+		SetRule("items", map_validator.ListOfObject(item)).
+		SetRule("tags", map_validator.List(map_validator.Str()).Nullable()).
+		Done()
+	req := newFormRequest(url.Values{
+		"user[name]":    {"Budi"},
+		"address.city":  {"Bandung"},
+		"items[0][sku]": {"A-1"},
+		"items[0][qty]": {"2"},
+		"items[1].sku":  {"B-2"},
+		"items[1].qty":  {"5"},
+		"tags[]":        {"new", "sale"},
+	})
+	check, err := map_validator.NewValidateBuilder().SetRules(rules).LoadFormHttp(req)
+	if err != nil {
+		t.Fatalf("Expected not have error, but got error : %s", err)
+	}
+	res, err := check.RunValidate()
+	if err != nil {
+		t.Fatalf("Expected not have error, but got error : %s", err)
+	}
+	var got formOrder
+	if err := res.Bind(&got); err != nil {
+		t.Fatalf("Expected not have error, but got error : %s", err)
+	}
+	if got.User.Name != "Budi" || got.Address.City != "Bandung" || len(got.Tags) != 2 {
+		t.Errorf("Expected nested values, but got %+v", got)
+	}
+	if len(got.Items) != 2 || got.Items[1].SKU != "B-2" || got.Items[1].Qty != 5 {
+		t.Errorf("Expected 2 items, but got %+v", got.Items)
+	}
+}
+
+func TestLoadFormHttpNestedNotationErrors(t *testing.T) {
+	item := map_validator.BuildRoles().
+		SetRule("sku", map_validator.Str()).
+		SetRule("qty", map_validator.Int().WithMin(1))
+	rules := map_validator.BuildRoles().
+		SetRule("items", map_validator.ListOfObject(item)).
+		SetRule("tags", map_validator.List(map_validator.Str()).Nullable()).
+		Done()
+	req := newFormRequest(url.Values{
+		"items[0][sku]": {"A-1"},
+		"items[0][qty]": {"0"},
+	})
+	check, err := map_validator.NewValidateBuilder().SetRules(rules).LoadFormHttp(req)
+	if err != nil {
+		t.Fatalf("Expected not have error, but got error : %s", err)
+	}
+	_, err = check.RunValidate()
+	var fieldErr *map_validator.FieldError
+	if !errors.As(err, &fieldErr) || fieldErr.Path != "items[0].qty" || fieldErr.Code != map_validator.CodeMin {
+		t.Errorf("Expected min error on items[0].qty, but got %v", err)
+	}
+}
+
+func TestLoadFormHttpSparseIndexes(t *testing.T) {
+	item := map_validator.BuildRoles().
+		SetRule("sku", map_validator.Str()).
+		SetRule("qty", map_validator.Int().WithMin(1))
+	rules := map_validator.BuildRoles().
+		SetRule("items", map_validator.ListOfObject(item)).
+		SetRule("tags", map_validator.List(map_validator.Str()).Nullable()).
+		Done()
+	req := newFormRequest(url.Values{
+		"items[0][sku]": {"A-1"},
+		"items[0][qty]": {"2"},
+		"items[5][sku]": {"B-2"},
+		"items[5][qty]": {"0"},
+	})
+	_, err := map_validator.NewValidateBuilder().SetRules(rules).LoadFormHttp(req)
+	var fieldErr *map_validator.FieldError
+	if !errors.As(err, &fieldErr) || fieldErr.Code != map_validator.CodeList || fieldErr.Path != "items" {
+		t.Fatalf("Expected list error on items, but got %v", err)
+	}
+	if err.Error() != "the field 'items' has no item at index 1" {
+		t.Errorf("Expected missing index message, but got %s", err)
+	}
+
+	req = newFormRequest(url.Values{
+		"items[1][sku]": {"A-1"},
+		"tags[0]":       {"new"},
+		"tags[2]":       {"sale"},
+	})
+	_, err = map_validator.NewValidateBuilder().SetLocale("id").SetRules(rules).LoadFormHttp(req)
+	var errs map_validator.ValidationErrors
+	if !errors.As(err, &errs) || len(errs) != 2 {
+		t.Fatalf("Expected 2 errors, but got %v", err)
+	}
+	expected := "field 'items' tidak memiliki item di indeks 0; field 'tags' tidak memiliki item di indeks 1"
+	if err.Error() != expected {
+		t.Errorf("Expected '%s', but got %s", expected, err)
+	}
+}
@@ -0,0 +1,108 @@
+package test
+
+import (
+	"errors"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Rhyanz46/go-map-validator/map_validator"
+)
+
+func TestLoadHeaderHttp(t *testing.T) {
+	rules := map_validator.BuildRoles().
+		SetRule("X-Request-ID", map_validator.UUID()).
+		SetRule("x-tenant-id", map_validator.Str().Regex(`^[a-z0-9-]+$`)).
+		SetRule("X-Env", map_validator.StrEnum("prod", "staging").Nullable()).
+		SetRule("idempotency_key", map_validator.Str().FromHeader("Idempotency-Key").Nullable()).
+		SetRule("X-Feature", map_validator.List(map_validator.Str()).Nullable()).
+		Done()
+	req := httptest.NewRequest("POST", "/test", nil)
+	req.Header.Set("x-request-id", "9d2c4b6e-4d0c-4a38-8a3e-1b2f3c4d5e6f")
+	req.Header.Set("X-Tenant-Id", "acme-1")
+	req.Header.Set("Idempotency-Key", "abc")
+	req.Header.Add("X-Feature", "a, b")
+	req.Header.Add("X-Feature", "c")
+
+	check, err := map_validator.NewValidateBuilder().SetRules(rules).LoadHeaderHttp(req)
+	if err != nil {
+		t.Fatalf("Expected not have error, but got error : %s", err)
+	}
+	res, err := check.RunValidate()
+	if err != nil {
+		t.Fatalf("Expected not have error, but got error : %s", err)
+	}
+	data := res.GetData()
+	if data["x-tenant-id"] != "acme-1" || data["idempotency_key"] != "abc" || data["X-Env"] != nil {
+		t.Errorf("Expected header values, but got %v", data)
+	}
+	if features, ok := data["X-Feature"].([]interface{}); !ok || len(features) != 3 || features[1] != "b" {
+		t.Errorf("Expected 3 features, but got %v", data["X-Feature"])
+	}
+}
+
+func TestLoadHeaderHttpErrorsNameHeader(t *testing.T) {
+	rules := map_validator.BuildRoles().
+		SetRule("X-Request-ID", map_validator.UUID()).
+		SetRule("x-tenant-id", map_validator.Str().Regex(`^[a-z0-9-]+$`)).
+		SetRule("X-Env", map_validator.StrEnum("prod", "staging").Nullable()).
+		SetRule("idempotency_key", map_validator.Str().FromHeader("Idempotency-Key").Nullable()).
+		SetRule("X-Feature", map_validator.List(map_validator.Str()).Nullable()).
+		Done()
+	testCases := []struct {
+		name   string
+		header map[string]string
+		field  string
+		code   string
+	}{
+		{"missing request id", map[string]string{"X-Tenant-ID": "acme"}, "X-Request-ID", map_validator.CodeRequired},
+		{"invalid request id", map[string]string{"X-Request-ID": "x", "X-Tenant-ID": "acme"}, "X-Request-ID", map_validator.CodeUUID},
+		{"tenant regex", map[string]string{"X-Request-ID": "9d2c4b6e-4d0c-4a38-8a3e-1b2f3c4d5e6f", "X-Tenant-ID": "ACME!"}, "x-tenant-id", map_validator.CodeRegex},
+		{"env enum", map[string]string{"X-Request-ID": "9d2c4b6e-4d0c-4a38-8a3e-1b2f3c4d5e6f", "X-Tenant-ID": "acme", "X-Env": "dev"}, "X-Env", map_validator.CodeEnum},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest("POST", "/test", nil)
+			for k, v := range tc.header {
+				req.Header.Set(k, v)
+			}
+			check, err := map_validator.NewValidateBuilder().SetRules(rules).LoadHeaderHttp(req)
+			if err != nil {
+				t.Fatalf("Expected not have error, but got error : %s", err)
+			}
+			_, err = check.RunValidate()
+			var fieldErr *map_validator.FieldError
+			if !errors.As(err, &fieldErr) || fieldErr.Field != tc.field || fieldErr.Code != tc.code {
+				t.Errorf("Expected %s error on %s, but got %v", tc.code, tc.field, err)
+			}
+		})
+	}
+}
+
+func TestLoadHeaderHttpErrorsNameSourceHeader(t *testing.T) {
+	rules := map_validator.BuildRoles().
+		SetRule("request_id", map_validator.UUID().FromHeader("X-Request-ID")).
+		SetRule("idempotency_key", map_validator.Str().WithMin(8).FromHeader("Idempotency-Key").WithMsg(map_validator.CustomMsg{
+			OnMin: map_validator.SetMessage("${source} ${field} is too short"),
+		})).
+		SetSetting(map_validator.BuildSetting().MakeAllErrors().Done()).
+		Done()
+	req := httptest.NewRequest("POST", "/test", nil)
+	req.Header.Set("Idempotency-Key", "abc")
+
+	check, err := map_validator.NewValidateBuilder().SetRules(rules).LoadHeaderHttp(req)
+	if err != nil {
+		t.Fatalf("Expected not have error, but got error : %s", err)
+	}
+	_, err = check.RunValidate()
+	var errs map_validator.ValidationErrors
+	if !errors.As(err, &errs) || len(errs) != 2 {
+		t.Fatalf("Expected 2 errors, but got %v", err)
+	}
+	fieldErrs := errs.FieldErrors()
+	if fieldErrs[0].Field != "Idempotency-Key" || fieldErrs[0].Path != "idempotency_key" || fieldErrs[0].Message != "header Idempotency-Key is too short" {
+		t.Errorf("Expected error naming Idempotency-Key, but got %s (%s): %s", fieldErrs[0].Field, fieldErrs[0].Path, fieldErrs[0].Message)
+	}
+	if fieldErrs[1].Field != "X-Request-ID" || fieldErrs[1].Message != "we need 'X-Request-ID' field" {
+		t.Errorf("Expected error naming X-Request-ID, but got %s: %s", fieldErrs[1].Field, fieldErrs[1].Message)
+	}
+}
@@ -0,0 +1,95 @@
+package test
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/gif"
+	"image/png"
+	"testing"
+
+	"github.com/Rhyanz46/go-map-validator/map_validator"
+)
+
+func encodeImage(t *testing.T, format string, width, height int) []byte {
+	t.Helper()
+	buf := &bytes.Buffer{}
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	var err error
+	if format == "gif" {
+		err = gif.Encode(buf, img, nil)
+	} else {
+		err = png.Encode(buf, img)
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestImageRules(t *testing.T) {
+	avatar := map_validator.ImageRules{
+		Formats:     []string{"png", "jpg"},
+		MinWidth:    10,
+		MaxWidth:    100,
+		AspectRatio: 1,
+	}
+	testCases := []struct {
+		name string
+		file uploadFile
+		code string
+	}{
+		{"not an image", uploadFile{"a.png", []byte("plain text")}, map_validator.CodeImage},
+		{"format", uploadFile{"a.gif", encodeImage(t, "gif", 20, 20)}, map_validator.CodeImageFormat},
+		{"too small", uploadFile{"a.png", encodeImage(t, "png", 5, 5)}, map_validator.CodeImageDimension},
+		{"too wide", uploadFile{"a.png", encodeImage(t, "png", 120, 120)}, map_validator.CodeImageDimension},
+		{"ratio", uploadFile{"a.png", encodeImage(t, "png", 40, 20)}, map_validator.CodeImageAspectRatio},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rules := map_validator.BuildRoles().SetRule("avatar", map_validator.Image(avatar)).Done()
+			req := newMultipartRequest(t, nil, map[string][]uploadFile{"avatar": {tc.file}})
+			check, err := map_validator.NewValidateBuilder().SetRules(rules).LoadFormHttp(req)
+			if err != nil {
+				t.Fatalf("Expected not have error, but got error : %s", err)
+			}
+			_, err = check.RunValidate()
+			var fieldErr *map_validator.FieldError
+			if !errors.As(err, &fieldErr) || fieldErr.Code != tc.code {
+				t.Errorf("Expected %s error, but got %v", tc.code, err)
+			}
+		})
+	}
+}
+
+func TestImageRulesKeepDimensions(t *testing.T) {
+	rules := map_validator.BuildRoles().
+		SetRule("avatar", map_validator.Image(map_validator.ImageRules{MaxWidth: 100}).MaxSize(1<<20)).
+		SetRule("banners", map_validator.List(map_validator.Image(map_validator.ImageRules{AspectRatio: 2}))).
+		Done()
+	req := newMultipartRequest(t, nil, map[string][]uploadFile{
+		"avatar":  {{"a.png", encodeImage(t, "png", 64, 48)}},
+		"banners": {{"b1.png", encodeImage(t, "png", 40, 20)}, {"b2.gif", encodeImage(t, "gif", 80, 40)}},
+	})
+	check, err := map_validator.NewValidateBuilder().SetRules(rules).LoadFormHttp(req)
+	if err != nil {
+		t.Fatalf("Expected not have error, but got error : %s", err)
+	}
+	res, err := check.RunValidate()
+	if err != nil {
+		t.Fatalf("Expected not have error, but got error : %s", err)
+	}
+	var got struct {
+		Avatar  map_validator.FileRequest   `json:"avatar"`
+		Banners []map_validator.FileRequest `json:"banners"`
+	}
+	if err := res.Bind(&got); err != nil {
+		t.Fatalf("Expected not have error, but got error : %s", err)
+	}
+	if got.Avatar.Image == nil || got.Avatar.Image.Width != 64 || got.Avatar.Image.Height != 48 || got.Avatar.Image.Format != "png" {
+		t.Errorf("Expected avatar image info, but got %+v", got.Avatar.Image)
+	}
+	if len(got.Banners) != 2 || got.Banners[1].Image == nil || got.Banners[1].Image.Format != "gif" {
+		t.Errorf("Expected banner image info, but got %+v", got.Banners)
+	}
+}
@@ -0,0 +1,87 @@
+package test
+
+import (
+	"bytes"
+	"errors"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/Rhyanz46/go-map-validator/map_validator"
+)
+
+func TestStrictIntegerKinds(t *testing.T) {
+	testCases := []struct {
+		name string
+		rule map_validator.Rules
+		body string
+		code string
+	}{
+		{"fraction", map_validator.Int(), `{"v": 1.5}`, map_validator.CodeType},
+		{"int8 overflow", map_validator.Rules{Type: reflect.Int8}, `{"v": 128}`, map_validator.CodeRange},
+		{"int8 underflow", map_validator.Rules{Type: reflect.Int8}, `{"v": -129}`, map_validator.CodeRange},
+		{"uint16 overflow", map_validator.Rules{Type: reflect.Uint16}, `{"v": 65536}`, map_validator.CodeRange},
+		{"uint negative", map_validator.Rules{Type: reflect.Uint}, `{"v": -1}`, map_validator.CodeRange},
+		{"int64 overflow", map_validator.Int64(), `{"v": 1e19}`, map_validator.CodeRange},
+		{"enum out of range", map_validator.Rules{Enum: &map_validator.EnumField[any]{Items: []int8{44}}}, `{"v": 300}`, map_validator.CodeRange},
+		{"list element", map_validator.List(map_validator.Int()), `{"v": [1, 2.5]}`, map_validator.CodeType},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rules := map_validator.BuildRoles().SetRule("v", tc.rule).Done()
+			req := httptest.NewRequest("POST", "/test", bytes.NewBufferString(tc.body))
+			check, err := map_validator.NewValidateBuilder().SetRules(rules).LoadJsonHttp(req)
+			if err != nil {
+				t.Fatalf("Expected not have error, but got error : %s", err)
+			}
+			_, err = check.RunValidate()
+			var fieldErr *map_validator.FieldError
+			if !errors.As(err, &fieldErr) || fieldErr.Code != tc.code {
+				t.Errorf("Expected %s error, but got %v", tc.code, err)
+			}
+		})
+	}
+}
+
+func TestStrictIntegerKindsPass(t *testing.T) {
+	rules := map_validator.BuildRoles().
+		SetRule("age", map_validator.Rules{Type: reflect.Uint8}).
+		SetRule("offset", map_validator.Rules{Type: reflect.Int16}).
+		SetRule("count", map_validator.Int64()).
+		Done()
+	body := `{"age": 255, "offset": -32768, "count": 2.0}`
+	req := httptest.NewRequest("POST", "/test", bytes.NewBufferString(body))
+	check, err := map_validator.NewValidateBuilder().SetRules(rules).LoadJsonHttp(req)
+	if err != nil {
+		t.Fatalf("Expected not have error, but got error : %s", err)
+	}
+	if _, err = check.RunValidate(); err != nil {
+		t.Errorf("Expected not have error, but got error : %s", err)
+	}
+}
+
+func TestStrictIntegerCustomMessage(t *testing.T) {
+	rules := map_validator.BuildRoles().
+		SetRule("qty", map_validator.Rules{Type: reflect.Int8}.WithMsg(map_validator.CustomMsg{
+			OnTypeNotMatch: map_validator.SetMessage("${field} must be a whole ${expected_type}"),
+		})).
+		Done()
+	testCases := []struct {
+		body     string
+		expected string
+	}{
+		{`{"qty": 1.5}`, "qty must be a whole int8"},
+		{`{"qty": 200}`, "qty must be a whole int8"},
+	}
+	for _, tc := range testCases {
+		req := httptest.NewRequest("POST", "/test", bytes.NewBufferString(tc.body))
+		check, err := map_validator.NewValidateBuilder().SetRules(rules).LoadJsonHttp(req)
+		if err != nil {
+			t.Fatalf("Expected not have error, but got error : %s", err)
+		}
+		_, err = check.RunValidate()
+		if err == nil || err.Error() != tc.expected {
+			t.Errorf("Expected '%s', but got %v", tc.expected, err)
+		}
+	}
+}
@@ -0,0 +1,41 @@
+package test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/Rhyanz46/go-map-validator/map_validator"
+)
+
+func TestIPV4NetworkWithoutType(t *testing.T) {
+	testCases := []struct {
+		name  string
+		value interface{}
+		code  string
+	}{
+		{"network", "192.168.1.0", ""},
+		{"host address", "192.168.1.5", map_validator.CodeIPv4Network},
+		{"not an ip", "subnet", map_validator.CodeIPv4Network},
+		{"number", 5, map_validator.CodeIPv4Network},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rules := map_validator.BuildRoles().SetRule("subnet", map_validator.Rules{IPV4Network: true}).Done()
+			check, err := map_validator.NewValidateBuilder().SetRules(rules).Load(map[string]interface{}{"subnet": tc.value})
+			if err != nil {
+				t.Fatalf("Expected not have error, but got error : %s", err)
+			}
+			_, err = check.RunValidate()
+			if tc.code == "" {
+				if err != nil {
+					t.Errorf("Expected not have error, but got error : %s", err)
+				}
+				return
+			}
+			var fieldErr *map_validator.FieldError
+			if !errors.As(err, &fieldErr) || fieldErr.Code != tc.code {
+				t.Errorf("Expected %s error, but got %v", tc.code, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,128 @@
+package test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/Rhyanz46/go-map-validator/map_validator"
+)
+
+func TestJSONLimits(t *testing.T) {
+	limits := map_validator.JSONLimits{
+		MaxBodyBytes:    256,
+		MaxDepth:        3,
+		MaxKeys:         4,
+		MaxArrayLength:  3,
+		MaxStringLength: 10,
+	}
+	testCases := []struct {
+		name     string
+		body     string
+		exceeded bool
+	}{
+		{"within limits", `{"a": {"b": [1, 2]}, "s": "short"}`, false},
+		{"body bytes", `{"a": "` + strings.Repeat("x", 300) + `"}`, true},
+		{"depth", `{"a": {"b": {"c": {"d": 1}}}}`, true},
+		{"keys", `{"a": 1, "b": 2, "c": 3, "d": 4, "e": 5}`, true},
+		{"array", `{"a": [1, 2, 3, 4]}`, true},
+		{"string", `{"a": "longer than ten"}`, true},
+		{"key length", `{"a_very_long_key": 1}`, true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rules := map_validator.BuildRoles().SetRule("a", map_validator.Any()).Done()
+			req := httptest.NewRequest("POST", "/test", bytes.NewBufferString(tc.body))
+			_, err := map_validator.NewValidateBuilder().SetJSONLimits(limits).SetRules(rules).LoadJsonHttp(req)
+			if errors.Is(err, map_validator.ErrLimitExceeded) != tc.exceeded {
+				t.Errorf("Expected exceeded=%v, but got %v", tc.exceeded, err)
+			}
+		})
+	}
+}
+
+func TestJSONLimitsFromSetting(t *testing.T) {
+	rules := map_validator.BuildRoles().
+		SetRule("name", map_validator.Str()).
+		SetSetting(map_validator.BuildSetting().WithJSONLimits(map_validator.JSONLimits{MaxBodyBytes: 16}).Done()).
+		Done()
+	req := httptest.NewRequest("POST", "/test", bytes.NewBufferString(`{"name": "a name longer than the limit"}`))
+	_, err := map_validator.ValidateJSON[map[string]interface{}](req, rules)
+	if !errors.Is(err, map_validator.ErrLimitExceeded) {
+		t.Fatalf("Expected ErrLimitExceeded, but got %v", err)
+	}
+
+	rec := httptest.NewRecorder()
+	_ = map_validator.WriteProblem(rec, err)
+	if rec.Code != http.StatusRequestEntityTooLarge {
+		t.Errorf("Expected 413, but got %d", rec.Code)
+	}
+}
+
+// endlessBody serves prefix, then chunk(0), chunk(1), ... forever
+type endlessBody struct {
+	pending string
+	chunk   func(i int) string
+	next    int
+	read    int64
+}
+
+func (b *endlessBody) Read(p []byte) (int, error) {
+	n := 0
+	for n < len(p) {
+		if b.pending == "" {
+			b.pending = b.chunk(b.next)
+			b.next++
+		}
+		c := copy(p[n:], b.pending)
+		b.pending = b.pending[c:]
+		n += c
+	}
+	b.read += int64(n)
+	return n, nil
+}
+
+func TestJSONLimitsStopWhileDecoding(t *testing.T) {
+	rules := map_validator.BuildRoles().SetRule("a", map_validator.Any()).Done()
+	testCases := []struct {
+		name   string
+		limits map_validator.JSONLimits
+		body   *endlessBody
+	}{
+		{"array length", map_validator.JSONLimits{MaxArrayLength: 100}, &endlessBody{pending: `{"a": [`, chunk: func(int) string { return `1,` }}},
+		{"depth", map_validator.JSONLimits{MaxDepth: 32}, &endlessBody{pending: `{"a": `, chunk: func(int) string { return `[` }}},
+		{"keys", map_validator.JSONLimits{MaxKeys: 50}, &endlessBody{pending: `{"a": {`, chunk: func(i int) string { return fmt.Sprintf(`"k%d": 1,`, i) }}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest("POST", "/test", tc.body)
+			_, err := map_validator.NewValidateBuilder().SetJSONLimits(tc.limits).SetRules(rules).LoadJsonHttp(req)
+			if !errors.Is(err, map_validator.ErrLimitExceeded) {
+				t.Fatalf("Expected ErrLimitExceeded, but got %v", err)
+			}
+			if tc.body.read > 1<<20 {
+				t.Errorf("Expected the body to be rejected early, but %d bytes were read", tc.body.read)
+			}
+		})
+	}
+}
+
+func TestJSONLimitsTruncatedBody(t *testing.T) {
+	rules := map_validator.BuildRoles().SetRule("name", map_validator.Str().Nullable()).Done()
+	for _, body := range []string{`{"name":`, `{"name": "a"`, `{`, `{"tags": [1, 2`} {
+		req := httptest.NewRequest("POST", "/test", bytes.NewBufferString(body))
+		_, err := map_validator.NewValidateBuilder().SetJSONLimits(map_validator.JSONLimits{MaxDepth: 5}).SetRules(rules).LoadJsonHttp(req)
+		if !errors.Is(err, map_validator.ErrInvalidJsonFormat) {
+			t.Errorf("Expected ErrInvalidJsonFormat for %s, but got %v", body, err)
+		}
+	}
+
+	req := httptest.NewRequest("POST", "/test", bytes.NewBufferString(""))
+	if _, err := map_validator.NewValidateBuilder().SetJSONLimits(map_validator.JSONLimits{MaxDepth: 5}).SetRules(rules).LoadJsonHttp(req); err != nil {
+		t.Errorf("Expected an empty body to load, but got error : %s", err)
+	}
+}
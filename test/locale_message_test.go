@@ -0,0 +1,101 @@
+package test
+
+import (
+	"bytes"
+	"errors"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Rhyanz46/go-map-validator/map_validator"
+)
+
+func TestLocaleIndonesianCatalog(t *testing.T) {
+	rules := map_validator.BuildRoles().
+		SetRule("name", map_validator.Str().WithMin(3)).
+		Done()
+	check, err := map_validator.NewValidateBuilder().SetLocale("id").SetRules(rules).Load(map[string]interface{}{"name": "a"})
+	if err != nil {
+		t.Fatalf("Expected not have error, but got error : %s", err)
+	}
+	_, err = check.RunValidate()
+	expected := "field 'name' minimal 3"
+	if err == nil || err.Error() != expected {
+		t.Errorf("Expected error '%s', but we got %v", expected, err)
+	}
+	var fieldErr *map_validator.FieldError
+	if !errors.As(err, &fieldErr) || fieldErr.Code != map_validator.CodeMin {
+		t.Errorf("Expected min FieldError, but got %v", err)
+	}
+}
+
+func TestLocaleFromAcceptLanguage(t *testing.T) {
+	rules := map_validator.BuildRoles().
+		SetRule("email", map_validator.Email()).
+		Done()
+	req := httptest.NewRequest("POST", "/test", bytes.NewBufferString(`{}`))
+	req.Header.Set("Accept-Language", "fr;q=0.9, id-ID, en;q=0.8")
+
+	check, err := map_validator.NewValidateBuilder().UseAcceptLanguage().SetRules(rules).LoadJsonHttp(req)
+	if err != nil {
+		t.Fatalf("Expected not have error, but got error : %s", err)
+	}
+	_, err = check.RunValidate()
+	expected := "field 'email' wajib diisi"
+	if err == nil || err.Error() != expected {
+		t.Errorf("Expected error '%s', but we got %v", expected, err)
+	}
+}
+
+func TestLocaleFromRequestUnknownLocale(t *testing.T) {
+	req := httptest.NewRequest("GET", "/test", nil)
+	req.Header.Set("Accept-Language", "fr-FR, de;q=0.5")
+	if locale := map_validator.LocaleFromRequest(req); locale != "" {
+		t.Errorf("Expected no locale, but got %s", locale)
+	}
+}
+
+func TestLocaleRegisterMessagesWithFallback(t *testing.T) {
+	map_validator.RegisterMessages("test-xx", map[string]string{
+		map_validator.CodeEmail: "${field_path} ist keine E-Mail",
+	})
+	child := map_validator.BuildRoles().SetRule("email", map_validator.Email())
+	rules := map_validator.BuildRoles().
+		SetRule("user", map_validator.NestedObject(child)).
+		SetRule("age", map_validator.Int()).
+		Done()
+	check, err := map_validator.NewValidateBuilder().SetRules(rules).Load(map[string]interface{}{
+		"user": map[string]interface{}{"email": "x"},
+	})
+	if err != nil {
+		t.Fatalf("Expected not have error, but got error : %s", err)
+	}
+	_, err = check.SetLocale("test-xx").RunValidateAll()
+	expected := "we need 'age' field; the field 'email' user.email ist keine E-Mail"
+	if err == nil || err.Error() != expected {
+		t.Errorf("Expected error '%s', but we got %v", expected, err)
+	}
+}
+
+func TestLocaleKeepsCustomMessage(t *testing.T) {
+	rules := map_validator.BuildRoles().
+		SetRule("name", map_validator.Str().WithMax(1).WithMsg(map_validator.CustomMsg{
+			OnMax: map_validator.SetMessage("too long"),
+		})).
+		Done()
+	check, err := map_validator.NewValidateBuilder().SetLocale("id").SetRules(rules).Load(map[string]interface{}{"name": "abc"})
+	if err != nil {
+		t.Fatalf("Expected not have error, but got error : %s", err)
+	}
+	_, err = check.RunValidate()
+	if err == nil || err.Error() != "too long" {
+		t.Errorf("Expected custom message, but we got %v", err)
+	}
+}
+
+func TestLocalizeValidateValueError(t *testing.T) {
+	_, err := map_validator.ValidateValue("x", map_validator.UUID(), map_validator.FromMapString)
+	err = map_validator.LocalizeError(err, "id")
+	if err == nil || err.Error() != "bukan uuid yang valid" {
+		t.Errorf("Expected localized message, but we got %v", err)
+	}
+}
@@ -0,0 +1,93 @@
+package test
+
+import (
+	"bytes"
+	"errors"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Rhyanz46/go-map-validator/map_validator"
+)
+
+func TestNumberBounds(t *testing.T) {
+	testCases := []struct {
+		name string
+		rule map_validator.Rules
+		body string
+		code string
+	}{
+		{"negative below min", map_validator.Int().WithMin(0), `{"v": -5}`, map_validator.CodeMin},
+		{"negative min", map_validator.Int().WithMin(-3), `{"v": -5}`, map_validator.CodeMin},
+		{"fraction above max", map_validator.Float64().WithMax(1), `{"v": 1.9}`, map_validator.CodeMax},
+		{"float gte", map_validator.Float64().Gte(0.5), `{"v": 0.25}`, map_validator.CodeMin},
+		{"float lte", map_validator.Float64().Lte(-0.5), `{"v": -0.25}`, map_validator.CodeMax},
+		{"gt", map_validator.Float64().Gt(1), `{"v": 1}`, map_validator.CodeMin},
+		{"lt", map_validator.Int().Lt(10), `{"v": 10}`, map_validator.CodeMax},
+		{"positive", map_validator.Int().Positive(), `{"v": 0}`, map_validator.CodeMin},
+		{"negative", map_validator.Float64().Negative(), `{"v": 0.5}`, map_validator.CodeMax},
+		{"non zero", map_validator.Float64().NonZero(), `{"v": 0}`, map_validator.CodeNonZero},
+		{"multiple of", map_validator.Int().MultipleOf(5), `{"v": 12}`, map_validator.CodeMultipleOf},
+		{"float multiple of", map_validator.Float64().MultipleOf(0.25), `{"v": 0.3}`, map_validator.CodeMultipleOf},
+		{"list element", map_validator.List(map_validator.Float64().WithMax(1)), `{"v": [0.5, 1.5]}`, map_validator.CodeMax},
+		{"list element rules", map_validator.List(map_validator.Int().Positive()), `{"v": [1, -1]}`, map_validator.CodeMin},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rules := map_validator.BuildRoles().SetRule("v", tc.rule).Done()
+			req := httptest.NewRequest("POST", "/test", bytes.NewBufferString(tc.body))
+			check, err := map_validator.NewValidateBuilder().SetRules(rules).LoadJsonHttp(req)
+			if err != nil {
+				t.Fatalf("Expected not have error, but got error : %s", err)
+			}
+			_, err = check.RunValidate()
+			var fieldErr *map_validator.FieldError
+			if !errors.As(err, &fieldErr) || fieldErr.Code != tc.code {
+				t.Errorf("Expected %s error, but got %v", tc.code, err)
+			}
+		})
+	}
+}
+
+func TestNumberBoundsPass(t *testing.T) {
+	rules := map_validator.BuildRoles().
+		SetRule("temp", map_validator.Float64().Gte(-40.5).Lte(60)).
+		SetRule("offset", map_validator.Int().Between(-10, 10).NonZero()).
+		SetRule("price", map_validator.Float64().Positive().MultipleOf(0.01)).
+		SetRule("step", map_validator.Int64().MultipleOf(5).Lt(100)).
+		Done()
+	payload := map[string]interface{}{"temp": -40.5, "offset": -10, "price": 19.99, "step": int64(95)}
+	check, err := map_validator.NewValidateBuilder().SetRules(rules).Load(payload)
+	if err != nil {
+		t.Fatalf("Expected not have error, but got error : %s", err)
+	}
+	if _, err = check.RunValidate(); err != nil {
+		t.Errorf("Expected not have error, but got error : %s", err)
+	}
+}
+
+func TestNumberBoundsMessages(t *testing.T) {
+	rules := map_validator.BuildRoles().
+		SetRule("balance", map_validator.Float64().WithMin(0).WithMsg(map_validator.CustomMsg{
+			OnMin: map_validator.SetMessage("${field} is ${actual_value}, min ${expected_min_length}"),
+		})).
+		SetRule("ratio", map_validator.Float64().Lt(1.5)).
+		SetRule("qty", map_validator.Int().MultipleOf(6)).
+		SetSetting(map_validator.BuildSetting().MakeAllErrors().Done()).
+		Done()
+	payload := map[string]interface{}{"balance": -2.5, "ratio": 1.5, "qty": 8}
+	check, err := map_validator.NewValidateBuilder().SetRules(rules).Load(payload)
+	if err != nil {
+		t.Fatalf("Expected not have error, but got error : %s", err)
+	}
+	_, err = check.RunValidate()
+	expected := "balance is -2.5, min 0; " +
+		"the field 'qty' should be a multiple of 6; " +
+		"the field 'ratio' should be lower than 1.5"
+	if err == nil || err.Error() != expected {
+		t.Errorf("Expected '%s', but got %v", expected, err)
+	}
+	var fieldErr *map_validator.FieldError
+	if errors.As(err, &fieldErr) && (fieldErr.Actual != -2.5 || fieldErr.Expected != int64(0)) {
+		t.Errorf("Expected -2.5/0, but got %v/%v", fieldErr.Actual, fieldErr.Expected)
+	}
+}
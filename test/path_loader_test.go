@@ -0,0 +1,85 @@
+//go:build go1.22
+
+package test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Rhyanz46/go-map-validator/map_validator"
+)
+
+func TestLoadPathHttpPathValue(t *testing.T) {
+	rules := map_validator.BuildRoles().
+		SetRule("id", map_validator.UUID()).
+		SetRule("version", map_validator.Int().WithMin(1)).
+		Done()
+	req := httptest.NewRequest("GET", "/users/9d2c4b6e-4d0c-4a38-8a3e-1b2f3c4d5e6f/v/3", nil)
+	req.SetPathValue("id", "9d2c4b6e-4d0c-4a38-8a3e-1b2f3c4d5e6f")
+	req.SetPathValue("version", "3")
+
+	check, err := map_validator.NewValidateBuilder().SetRules(rules).LoadPathHttp(req)
+	if err != nil {
+		t.Fatalf("Expected not have error, but got error : %s", err)
+	}
+	res, err := check.RunValidate()
+	if err != nil {
+		t.Fatalf("Expected not have error, but got error : %s", err)
+	}
+	if res.GetData()["version"] != 3 {
+		t.Errorf("Expected version 3, but got %v", res.GetData()["version"])
+	}
+}
+
+func TestLoadPathHttpErrors(t *testing.T) {
+	rules := map_validator.BuildRoles().SetRule("id", map_validator.Int()).Done()
+
+	req := httptest.NewRequest("GET", "/users/abc", nil)
+	req.SetPathValue("id", "abc")
+	check, err := map_validator.NewValidateBuilder().SetRules(rules).LoadPathHttp(req)
+	if err != nil {
+		t.Fatalf("Expected not have error, but got error : %s", err)
+	}
+	_, err = check.RunValidate()
+	var fieldErr *map_validator.FieldError
+	if !errors.As(err, &fieldErr) || fieldErr.Code != map_validator.CodeType || fieldErr.Field != "id" {
+		t.Errorf("Expected type error on id, but got %v", err)
+	}
+
+	check, err = map_validator.NewValidateBuilder().SetRules(rules).LoadPathHttp(httptest.NewRequest("GET", "/users", nil))
+	if err != nil {
+		t.Fatalf("Expected not have error, but got error : %s", err)
+	}
+	_, err = check.RunValidate()
+	if !errors.As(err, &fieldErr) || fieldErr.Code != map_validator.CodeRequired {
+		t.Errorf("Expected required error on id, but got %v", err)
+	}
+}
+
+func TestLoadPathHttpCustomExtractor(t *testing.T) {
+	params := map[string]string{"slug": "hello-world"}
+	extractor := map_validator.PathParamFunc(func(r *http.Request, name string) (string, bool) {
+		v, ok := params[name]
+		return v, ok
+	})
+	rules := map_validator.BuildRoles().
+		SetRule("slug", map_validator.Str().Regex(`^[a-z-]+$`)).
+		Done()
+
+	check, err := map_validator.NewValidateBuilder().
+		SetPathParamExtractor(extractor).
+		SetRules(rules).
+		LoadPathHttp(httptest.NewRequest("GET", "/posts/hello-world", nil))
+	if err != nil {
+		t.Fatalf("Expected not have error, but got error : %s", err)
+	}
+	res, err := check.RunValidate()
+	if err != nil {
+		t.Fatalf("Expected not have error, but got error : %s", err)
+	}
+	if res.GetData()["slug"] != "hello-world" {
+		t.Errorf("Expected slug, but got %v", res.GetData()["slug"])
+	}
+}
@@ -0,0 +1,109 @@
+package test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Rhyanz46/go-map-validator/map_validator"
+)
+
+func decodeProblem(t *testing.T, rec *httptest.ResponseRecorder) map_validator.Problem {
+	t.Helper()
+	if ct := rec.Header().Get("Content-Type"); ct != map_validator.ProblemContentType {
+		t.Errorf("Expected content type %s, but got %s", map_validator.ProblemContentType, ct)
+	}
+	var p map_validator.Problem
+	if err := json.NewDecoder(rec.Body).Decode(&p); err != nil {
+		t.Fatalf("Expected problem body, but got error : %s", err)
+	}
+	return p
+}
+
+func TestProblemFromValidationErrors(t *testing.T) {
+	address := map_validator.BuildRoles().SetRule("city", map_validator.Str().WithMin(3))
+	rules := map_validator.BuildRoles().
+		SetRule("email", map_validator.Email()).
+		SetRule("address", map_validator.NestedObject(address)).
+		SetSetting(map_validator.BuildSetting().MakeAllErrors().Done()).
+		Done()
+	req := httptest.NewRequest("POST", "/test", bytes.NewBufferString(`{"email": "x", "address": {"city": "a"}}`))
+	_, err := map_validator.ValidateJSON[map[string]interface{}](req, rules)
+
+	rec := httptest.NewRecorder()
+	if err := map_validator.WriteProblem(rec, err); err != nil {
+		t.Fatalf("Expected not have error, but got error : %s", err)
+	}
+	if rec.Code != http.StatusUnprocessableEntity {
+		t.Errorf("Expected status 422, but got %d", rec.Code)
+	}
+	p := decodeProblem(t, rec)
+	if p.Status != http.StatusUnprocessableEntity || len(p.Errors) != 2 {
+		t.Fatalf("Expected 422 with 2 errors, but got %+v", p)
+	}
+	if p.Errors[0].Path != "address.city" || p.Errors[0].Pointer != "/address/city" || p.Errors[0].Code != map_validator.CodeMin {
+		t.Errorf("Expected address.city min error, but got %+v", p.Errors[0])
+	}
+	if p.Errors[1].Path != "email" || p.Errors[1].Message != "the field 'email' is not valid email" {
+		t.Errorf("Expected email error, but got %+v", p.Errors[1])
+	}
+}
+
+func TestProblemResponderCustomStatus(t *testing.T) {
+	rules := map_validator.BuildRoles().SetRule("name", map_validator.Str()).Done()
+	req := httptest.NewRequest("POST", "/test", bytes.NewBufferString(`{}`))
+	_, err := map_validator.ValidateJSON[map[string]interface{}](req, rules)
+
+	rec := httptest.NewRecorder()
+	responder := map_validator.ProblemResponder{
+		Status: http.StatusBadRequest,
+		Type:   "https://example.com/problems/validation",
+		Title:  "Invalid input",
+	}
+	_ = responder.Write(rec, err)
+	p := decodeProblem(t, rec)
+	if rec.Code != http.StatusBadRequest || p.Type != responder.Type || p.Title != responder.Title {
+		t.Errorf("Expected custom status, type and title, but got %d %+v", rec.Code, p)
+	}
+	if len(p.Errors) != 1 || p.Errors[0].Code != map_validator.CodeRequired {
+		t.Errorf("Expected required error, but got %+v", p.Errors)
+	}
+}
+
+func TestProblemInvalidJSON(t *testing.T) {
+	rules := map_validator.BuildRoles().SetRule("name", map_validator.Str()).Done()
+	req := httptest.NewRequest("POST", "/test", bytes.NewBufferString(`{not valid`))
+	_, err := map_validator.ValidateJSON[map[string]interface{}](req, rules)
+
+	rec := httptest.NewRecorder()
+	_ = map_validator.WriteProblem(rec, err)
+	p := decodeProblem(t, rec)
+	if rec.Code != http.StatusBadRequest || len(p.Errors) != 0 || p.Detail == "" {
+		t.Errorf("Expected 400 with detail, but got %d %+v", rec.Code, p)
+	}
+}
+
+func TestProblemHidesInternalErrors(t *testing.T) {
+	req := httptest.NewRequest("POST", "/test", bytes.NewBufferString(`{}`))
+	_, err := map_validator.ValidateJSON[map[string]interface{}](req, map_validator.BuildRoles().Done())
+	if !errors.Is(err, map_validator.ErrNoRules) {
+		t.Fatalf("Expected ErrNoRules, but got %v", err)
+	}
+
+	rec := httptest.NewRecorder()
+	_ = map_validator.WriteProblem(rec, err)
+	p := decodeProblem(t, rec)
+	if rec.Code != http.StatusInternalServerError || p.Detail != "" {
+		t.Errorf("Expected 500 without detail, but got %d %+v", rec.Code, p)
+	}
+
+	rec = httptest.NewRecorder()
+	_ = map_validator.ProblemResponder{ExposeInternalErrors: true}.Write(rec, err)
+	p = decodeProblem(t, rec)
+	if p.Detail != map_validator.ErrNoRules.Error() {
+		t.Errorf("Expected exposed detail, but got %+v", p)
+	}
+}
@@ -0,0 +1,93 @@
+package test
+
+import (
+	"errors"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/Rhyanz46/go-map-validator/map_validator"
+)
+
+type listUsersQuery struct {
+	Page   int      `json:"page"`
+	Sort   string   `json:"sort"`
+	Status []string `json:"status"`
+}
+
+func TestValidateQuery(t *testing.T) {
+	rules := map_validator.BuildRoles().
+		SetRule("page", map_validator.Int().WithMin(1).Nullable().Default(1)).
+		SetRule("sort", map_validator.StrEnum("name", "created_at")).
+		SetRule("status", map_validator.List(map_validator.StrEnum("active", "pending", "banned")).Nullable()).
+		Done()
+	req := httptest.NewRequest("GET", "/users?page=2&sort=name&status=active&status=pending", nil)
+	got, err := map_validator.ValidateQuery[listUsersQuery](req, rules)
+	if err != nil {
+		t.Fatalf("Expected not have error, but got error : %s", err)
+	}
+	if got.Page != 2 || got.Sort != "name" || len(got.Status) != 2 || got.Status[1] != "pending" {
+		t.Errorf("Expected bound query, but got %+v", got)
+	}
+}
+
+func TestValidateQueryDefaultsAndSingleValueList(t *testing.T) {
+	rules := map_validator.BuildRoles().
+		SetRule("page", map_validator.Int().WithMin(1).Nullable().Default(1)).
+		SetRule("sort", map_validator.StrEnum("name", "created_at")).
+		SetRule("status", map_validator.List(map_validator.StrEnum("active", "pending", "banned")).Nullable()).
+		Done()
+	req := httptest.NewRequest("GET", "/users?sort=created_at&status=banned", nil)
+	got, err := map_validator.ValidateQuery[listUsersQuery](req, rules)
+	if err != nil {
+		t.Fatalf("Expected not have error, but got error : %s", err)
+	}
+	if got.Page != 1 || len(got.Status) != 1 || got.Status[0] != "banned" {
+		t.Errorf("Expected default page and one status, but got %+v", got)
+	}
+}
+
+func TestValidateQueryErrors(t *testing.T) {
+	rules := map_validator.BuildRoles().
+		SetRule("page", map_validator.Int().WithMin(1).Nullable().Default(1)).
+		SetRule("sort", map_validator.StrEnum("name", "created_at")).
+		SetRule("status", map_validator.List(map_validator.StrEnum("active", "pending", "banned")).Nullable()).
+		Done()
+	testCases := []struct {
+		name  string
+		query string
+		path  string
+		code  string
+	}{
+		{"page not a number", "page=abc&sort=name", "page", map_validator.CodeType},
+		{"page below min", "page=0&sort=name", "page", map_validator.CodeMin},
+		{"sort missing", "page=1", "sort", map_validator.CodeRequired},
+		{"bad status item", "sort=name&status=active&status=deleted", "status[1]", map_validator.CodeEnum},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/users?"+tc.query, nil)
+			_, err := map_validator.ValidateQuery[listUsersQuery](req, rules)
+			var fieldErr *map_validator.FieldError
+			if !errors.As(err, &fieldErr) || fieldErr.Code != tc.code || fieldErr.Path != tc.path {
+				t.Errorf("Expected %s error on %s, but got %v", tc.code, tc.path, err)
+			}
+		})
+	}
+}
+
+func TestLoadQueryHttpStrict(t *testing.T) {
+	rules := map_validator.BuildRoles().
+		SetRule("q", map_validator.Str()).
+		SetSetting(map_validator.Setting{Strict: true}).
+		Done()
+	req := httptest.NewRequest("GET", "/search?q=go&debug=1", nil)
+	check, err := map_validator.NewValidateBuilder().SetRules(rules).LoadQueryHttp(req)
+	if err != nil {
+		t.Fatalf("Expected not have error, but got error : %s", err)
+	}
+	_, err = check.RunValidate()
+	if err == nil || !strings.Contains(err.Error(), "'debug' is not allowed key") {
+		t.Errorf("Expected strict key error, but got %v", err)
+	}
+}
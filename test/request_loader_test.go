@@ -0,0 +1,106 @@
+//go:build go1.22
+
+package test
+
+import (
+	"bytes"
+	"errors"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Rhyanz46/go-map-validator/map_validator"
+)
+
+type updateOrderRequest struct {
+	ID     string `json:"id"`
+	Notify bool   `json:"notify"`
+	Tenant string `json:"tenant"`
+	Qty    int    `json:"qty"`
+}
+
+func TestLoadRequestHttpMergesSources(t *testing.T) {
+	rules := map_validator.BuildRoles().
+		SetRule("id", map_validator.UUID().FromPath()).
+		SetRule("notify", map_validator.Bool().FromQuery().Nullable()).
+		SetRule("tenant", map_validator.Str().FromHeader("X-Tenant-ID")).
+		SetRule("qty", map_validator.Int().WithMin(1)).
+		Done()
+	req := httptest.NewRequest("PUT", "/orders/9d2c4b6e-4d0c-4a38-8a3e-1b2f3c4d5e6f?notify=true", bytes.NewBufferString(`{"qty": 3}`))
+	req.SetPathValue("id", "9d2c4b6e-4d0c-4a38-8a3e-1b2f3c4d5e6f")
+	req.Header.Set("x-tenant-id", "acme")
+
+	check, err := map_validator.NewValidateBuilder().SetRules(rules).LoadRequestHttp(req)
+	if err != nil {
+		t.Fatalf("Expected not have error, but got error : %s", err)
+	}
+	res, err := check.RunValidate()
+	if err != nil {
+		t.Fatalf("Expected not have error, but got error : %s", err)
+	}
+	var got updateOrderRequest
+	if err := res.Bind(&got); err != nil {
+		t.Fatalf("Expected not have error, but got error : %s", err)
+	}
+	if got.ID != "9d2c4b6e-4d0c-4a38-8a3e-1b2f3c4d5e6f" || !got.Notify || got.Tenant != "acme" || got.Qty != 3 {
+		t.Errorf("Expected every source bound, but got %+v", got)
+	}
+}
+
+func TestLoadRequestHttpValidatesEachSource(t *testing.T) {
+	rules := map_validator.BuildRoles().
+		SetRule("id", map_validator.UUID().FromPath()).
+		SetRule("notify", map_validator.Bool().FromQuery().Nullable()).
+		SetRule("tenant", map_validator.Str().FromHeader("X-Tenant-ID")).
+		SetRule("qty", map_validator.Int().WithMin(1)).
+		Done()
+	req := httptest.NewRequest("PUT", "/orders/x", bytes.NewBufferString(`{"qty": 3}`))
+	req.SetPathValue("id", "x")
+
+	check, err := map_validator.NewValidateBuilder().SetRules(rules).LoadRequestHttp(req)
+	if err != nil {
+		t.Fatalf("Expected not have error, but got error : %s", err)
+	}
+	_, err = check.RunValidateAll()
+	var validationErrs map_validator.ValidationErrors
+	if !errors.As(err, &validationErrs) || len(validationErrs) != 2 {
+		t.Fatalf("Expected 2 errors, but got %v", err)
+	}
+	fieldErrs := validationErrs.FieldErrors()
+	if fieldErrs[0].Code != map_validator.CodeUUID || fieldErrs[1].Field != "X-Tenant-ID" || fieldErrs[1].Path != "tenant" || fieldErrs[1].Code != map_validator.CodeRequired {
+		t.Errorf("Expected uuid and tenant errors, but got %v", err)
+	}
+}
+
+func TestLoadRequestHttpSourceConflict(t *testing.T) {
+	rules := map_validator.BuildRoles().
+		SetRule("id", map_validator.UUID().FromPath()).
+		SetRule("notify", map_validator.Bool().FromQuery().Nullable()).
+		SetRule("tenant", map_validator.Str().FromHeader("X-Tenant-ID")).
+		SetRule("qty", map_validator.Int().WithMin(1)).
+		Done()
+	req := httptest.NewRequest("PUT", "/orders/9d2c4b6e-4d0c-4a38-8a3e-1b2f3c4d5e6f", bytes.NewBufferString(`{"qty": 3, "id": "other"}`))
+	req.SetPathValue("id", "9d2c4b6e-4d0c-4a38-8a3e-1b2f3c4d5e6f")
+	req.Header.Set("X-Tenant-ID", "acme")
+
+	_, err := map_validator.NewValidateBuilder().SetRules(rules).LoadRequestHttp(req)
+	var fieldErr *map_validator.FieldError
+	if !errors.As(err, &fieldErr) || fieldErr.Code != map_validator.CodeSourceConflict {
+		t.Fatalf("Expected source conflict, but got %v", err)
+	}
+	if err.Error() != "the field 'id' should be sent in the path, not in the body" {
+		t.Errorf("Expected conflict message, but got %s", err)
+	}
+}
+
+func TestLoadRequestHttpPathListUnsupported(t *testing.T) {
+	rules := map_validator.BuildRoles().
+		SetRule("ids", map_validator.List(map_validator.Int()).FromPath()).
+		Done()
+	req := httptest.NewRequest("GET", "/orders/1", nil)
+	req.SetPathValue("ids", "1")
+
+	_, err := map_validator.NewValidateBuilder().SetRules(rules).LoadRequestHttp(req)
+	if !errors.Is(err, map_validator.ErrUnsupportType) {
+		t.Errorf("Expected ErrUnsupportType, but got %v", err)
+	}
+}
@@ -0,0 +1,178 @@
+package test
+
+import (
+	"bytes"
+	"errors"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Rhyanz46/go-map-validator/map_validator"
+)
+
+func TestRequiredWhen(t *testing.T) {
+	overHundred := map_validator.FieldMatches("total", "total is over 100", func(value interface{}) bool {
+		total, ok := value.(float64)
+		return ok && total > 100
+	})
+	testCases := []struct {
+		name     string
+		rule     map_validator.Rules
+		payload  map[string]interface{}
+		code     string
+		expected string
+	}{
+		{
+			"equals", map_validator.Str().WithRequiredWhen(map_validator.FieldEquals("status", "shipped")),
+			map[string]interface{}{"status": "shipped"},
+			map_validator.CodeRequiredWhen, "we need 'v' field when status == shipped",
+		},
+		{"equals not met", map_validator.Str().WithRequiredWhen(map_validator.FieldEquals("status", "shipped")), map[string]interface{}{"status": "pending"}, "", ""},
+		{"equals filled", map_validator.Str().WithRequiredWhen(map_validator.FieldEquals("status", "shipped")), map[string]interface{}{"status": "shipped", "v": "JNE123"}, "", ""},
+		{
+			"in", map_validator.Str().WithRequiredWhen(map_validator.FieldIn("account_type", "business", "enterprise")),
+			map[string]interface{}{"account_type": "enterprise"},
+			map_validator.CodeRequiredWhen, "we need 'v' field when account_type in [business, enterprise]",
+		},
+		{"in not met", map_validator.Str().WithRequiredWhen(map_validator.FieldIn("account_type", "business", "enterprise")), map[string]interface{}{"account_type": "personal"}, "", ""},
+		{
+			"not equals", map_validator.Str().WithRequiredWhen(map_validator.FieldNotEquals("country", "ID")),
+			map[string]interface{}{"country": "SG"},
+			map_validator.CodeRequiredWhen, "we need 'v' field when country != ID",
+		},
+		{"equals is exact", map_validator.Str().WithRequiredWhen(map_validator.FieldEquals("day", "2024-01-01")), map[string]interface{}{"day": "2024-01-01 00:00:00"}, "", ""},
+		{"in is exact", map_validator.Str().WithRequiredWhen(map_validator.FieldIn("at", "2024-03-01T08:00:00+07:00")), map[string]interface{}{"at": "2024-03-01T01:00:00Z"}, "", ""},
+		{"equals does not parse dates", map_validator.Str().WithRequiredWhen(map_validator.FieldEquals("day", "2024-01-01")), map[string]interface{}{"day": time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}, "", ""},
+		{"number equals", map_validator.Str().WithRequiredWhen(map_validator.FieldEquals("qty", 3)), map[string]interface{}{"qty": 3.0}, map_validator.CodeRequiredWhen, "we need 'v' field when qty == 3"},
+		{
+			"predicate", map_validator.Str().WithRequiredWhen(overHundred),
+			map[string]interface{}{"total": 150.0},
+			map_validator.CodeRequiredWhen, "we need 'v' field when total is over 100",
+		},
+		{"predicate not met", map_validator.Str().WithRequiredWhen(overHundred), map[string]interface{}{"total": 50.0}, "", ""},
+		{
+			"forbidden", map_validator.Str().Nullable().WithForbiddenWhen(map_validator.FieldEquals("plan", "free")),
+			map[string]interface{}{"plan": "free", "v": "PROMO"},
+			map_validator.CodeForbiddenWhen, "'v' is not allowed when plan == free",
+		},
+		{"forbidden not met", map_validator.Str().Nullable().WithForbiddenWhen(map_validator.FieldEquals("plan", "free")), map[string]interface{}{"plan": "pro", "v": "PROMO"}, "", ""},
+		{"forbidden absent", map_validator.Str().WithForbiddenWhen(map_validator.FieldEquals("plan", "free")), map[string]interface{}{"plan": "free"}, "", ""},
+		{"required unless forbidden", map_validator.Str().WithForbiddenWhen(map_validator.FieldEquals("plan", "free")), map[string]interface{}{"plan": "pro"}, map_validator.CodeRequired, "we need 'v' field"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rules := map_validator.BuildRoles().SetRule("v", tc.rule).Done()
+			check, err := map_validator.NewValidateBuilder().SetRules(rules).Load(tc.payload)
+			if err != nil {
+				t.Fatalf("Expected not have error, but got error : %s", err)
+			}
+			_, err = check.RunValidate()
+			if tc.code == "" {
+				if err != nil {
+					t.Errorf("Expected not have error, but got error : %s", err)
+				}
+				return
+			}
+			var fieldErr *map_validator.FieldError
+			if !errors.As(err, &fieldErr) || fieldErr.Code != tc.code || err.Error() != tc.expected {
+				t.Errorf("Expected %s '%s', but got %v", tc.code, tc.expected, err)
+			}
+		})
+	}
+}
+
+func TestRequiredWhenMessagesAndPaths(t *testing.T) {
+	shipment := map_validator.BuildRoles().
+		SetRule("status", map_validator.StrEnum("pending", "shipped")).
+		SetRule("tracking_number", map_validator.Str().WithRequiredWhen(map_validator.FieldEquals("status", "shipped")))
+	rules := map_validator.BuildRoles().
+		SetRule("account_type", map_validator.Str()).
+		SetRule("company_name", map_validator.Str().WithRequiredWhen(map_validator.FieldIn("account_type", "business", "enterprise")).WithMsg(map_validator.CustomMsg{
+			OnRequiredWhen: map_validator.SetMessage("${field} is needed for ${condition_field} ${condition_values}"),
+		})).
+		SetRule("shipments", map_validator.ListOfObject(shipment)).
+		SetSetting(map_validator.BuildSetting().MakeAllErrors().Done()).
+		Done()
+	payload := map[string]interface{}{
+		"account_type": "business",
+		"shipments": []interface{}{
+			map[string]interface{}{"status": "pending"},
+			map[string]interface{}{"status": "shipped"},
+		},
+	}
+	check, err := map_validator.NewValidateBuilder().SetLocale("id").SetRules(rules).Load(payload)
+	if err != nil {
+		t.Fatalf("Expected not have error, but got error : %s", err)
+	}
+	_, err = check.RunValidate()
+	var errs map_validator.ValidationErrors
+	if !errors.As(err, &errs) {
+		t.Fatalf("Expected ValidationErrors, but got %v", err)
+	}
+	fieldErrs := errs.FieldErrors()
+	if len(fieldErrs) != 2 {
+		t.Fatalf("Expected 2 errors, but got %v", err)
+	}
+	if fieldErrs[0].Path != "company_name" || fieldErrs[0].Message != "company_name is needed for account_type business, enterprise" {
+		t.Errorf("Expected custom message, but got %s: %s", fieldErrs[0].Path, fieldErrs[0].Message)
+	}
+	if fieldErrs[1].Path != "shipments[1].tracking_number" || fieldErrs[1].Message != "field 'tracking_number' wajib diisi jika status == shipped" {
+		t.Errorf("Expected localized item error, but got %s: %s", fieldErrs[1].Path, fieldErrs[1].Message)
+	}
+	if fieldErrs[1].Actual != "shipped" {
+		t.Errorf("Expected the triggering value, but got %v", fieldErrs[1].Actual)
+	}
+}
+
+func TestRequiredWhenUseNumber(t *testing.T) {
+	rules := map_validator.BuildRoles().
+		SetRule("qty", map_validator.Any()).
+		SetRule("note", map_validator.Str().WithRequiredWhen(map_validator.FieldEquals("qty", 3))).
+		SetRule("bulk_code", map_validator.Str().WithRequiredWhen(map_validator.FieldIn("qty", 10, 20))).
+		SetRule("coupon", map_validator.Str().Nullable().WithForbiddenWhen(map_validator.FieldEquals("qty", 3))).
+		Done()
+	testCases := []struct {
+		body string
+		code string
+	}{
+		{`{"qty": 3}`, map_validator.CodeRequiredWhen},
+		{`{"qty": 20}`, map_validator.CodeRequiredWhen},
+		{`{"qty": 3, "note": "x", "coupon": "FREE"}`, map_validator.CodeForbiddenWhen},
+		{`{"qty": 4}`, ""},
+	}
+	for _, tc := range testCases {
+		req := httptest.NewRequest("POST", "/test", bytes.NewBufferString(tc.body))
+		check, err := map_validator.NewValidateBuilder().UseNumber().SetRules(rules).LoadJsonHttp(req)
+		if err != nil {
+			t.Fatalf("Expected not have error, but got error : %s", err)
+		}
+		_, err = check.RunValidate()
+		var fieldErr *map_validator.FieldError
+		if tc.code == "" && err != nil {
+			t.Errorf("Expected %s to pass, but got error : %s", tc.body, err)
+		}
+		if tc.code != "" && (!errors.As(err, &fieldErr) || fieldErr.Code != tc.code) {
+			t.Errorf("Expected %s error for %s, but got %v", tc.code, tc.body, err)
+		}
+	}
+}
+
+func TestRequiredWhenConditionWithoutValues(t *testing.T) {
+	rules := map_validator.BuildRoles().
+		SetRule("note", map_validator.Str().WithRequiredWhen(
+			map_validator.Condition{Field: "status", Op: map_validator.ConditionNotEquals},
+			map_validator.Condition{Field: "status", Op: map_validator.ConditionEquals},
+		)).
+		SetRule("coupon", map_validator.Str().Nullable().WithForbiddenWhen(map_validator.Condition{Field: "status", Op: map_validator.ConditionIn})).
+		Done()
+	check, err := map_validator.NewValidateBuilder().SetRules(rules).Load(map[string]interface{}{"status": "shipped", "coupon": "FREE"})
+	if err != nil {
+		t.Fatalf("Expected not have error, but got error : %s", err)
+	}
+	if _, err = check.RunValidate(); err != nil {
+		t.Errorf("Expected conditions without values to never hold, but got error : %s", err)
+	}
+	if got := (map_validator.Condition{Field: "status", Op: map_validator.ConditionNotEquals}).String(); got != "status != " {
+		t.Errorf("Expected 'status != ', but got '%s'", got)
+	}
+}
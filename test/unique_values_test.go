@@ -1,6 +1,7 @@
 package test
 
 import (
+	"errors"
 	"reflect"
 	"testing"
 
@@ -171,3 +172,92 @@ func TestChildUniqueValueWithCustomMsg(t *testing.T) {
 		t.Errorf("Expected :%s. But you got : %s", expected, err)
 	}
 }
+
+func TestUniqueListItems(t *testing.T) {
+	lineItem := map_validator.BuildRoles().
+		SetRule("sku", map_validator.Str()).
+		SetRule("qty", map_validator.Int())
+	testCases := []struct {
+		name     string
+		rule     map_validator.Rules
+		payload  interface{}
+		expected string
+		path     string
+	}{
+		{
+			"primitive list",
+			map_validator.List(map_validator.Str()).UniqueItems(),
+			[]interface{}{"go", "api", "go"},
+			"the field 'items' has duplicate items at index 0 and 2",
+			"items[2]",
+		},
+		{
+			"list rules flag",
+			map_validator.Rules{Type: reflect.Float64, List: map_validator.BuildListRoles().SetListRule(map_validator.ListRules{Unique: true})},
+			[]interface{}{1.5, 2.0, 2.0},
+			"the field 'items' has duplicate items at index 1 and 2",
+			"items[2]",
+		},
+		{
+			"list of object key",
+			map_validator.ListOfObject(lineItem).UniqueItems("sku"),
+			[]interface{}{
+				map[string]interface{}{"sku": "A-1", "qty": 1},
+				map[string]interface{}{"sku": "B-2", "qty": 1},
+				map[string]interface{}{"sku": "A-1", "qty": 3},
+			},
+			"the field 'items' has duplicate sku at index 0 and 2",
+			"items[2]",
+		},
+		{
+			"custom message",
+			map_validator.List(map_validator.Int()).UniqueItems().WithMsg(map_validator.CustomMsg{
+				OnUnique: map_validator.SetMessage("'${unique_origin}' and '${unique_target}' repeat"),
+			}),
+			[]interface{}{1, 1},
+			"'items[0]' and 'items[1]' repeat",
+			"items[1]",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rules := map_validator.BuildRoles().SetRule("items", tc.rule).Done()
+			check, err := map_validator.NewValidateBuilder().SetRules(rules).Load(map[string]interface{}{"items": tc.payload})
+			if err != nil {
+				t.Fatalf("Expected not have error, but got error : %s", err)
+			}
+			_, err = check.RunValidate()
+			var fieldErr *map_validator.FieldError
+			if !errors.As(err, &fieldErr) || fieldErr.Code != map_validator.CodeUnique {
+				t.Fatalf("Expected unique error, but got %v", err)
+			}
+			if err.Error() != tc.expected || fieldErr.Path != tc.path {
+				t.Errorf("Expected '%s' at %s, but got '%s' at %s", tc.expected, tc.path, err, fieldErr.Path)
+			}
+		})
+	}
+}
+
+func TestUniqueListItemsPass(t *testing.T) {
+	lineItem := map_validator.BuildRoles().
+		SetRule("sku", map_validator.Str()).
+		SetRule("warehouse", map_validator.Str().Nullable())
+	rules := map_validator.BuildRoles().
+		SetRule("tags", map_validator.List(map_validator.Str()).UniqueItems()).
+		SetRule("lines", map_validator.ListOfObject(lineItem).UniqueItems("sku", "warehouse")).
+		Done()
+	payload := map[string]interface{}{
+		"tags": []interface{}{"go", "Go"},
+		"lines": []interface{}{
+			map[string]interface{}{"sku": "A-1", "warehouse": "JKT"},
+			map[string]interface{}{"sku": "A-1", "warehouse": "SBY"},
+		},
+	}
+	check, err := map_validator.NewValidateBuilder().SetRules(rules).Load(payload)
+	if err != nil {
+		t.Fatalf("Expected not have error, but got error : %s", err)
+	}
+	if _, err = check.RunValidate(); err != nil {
+		t.Errorf("Expected not have error, but got error : %s", err)
+	}
+}
@@ -0,0 +1,78 @@
+package test
+
+import (
+	"bytes"
+	"errors"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/Rhyanz46/go-map-validator/map_validator"
+)
+
+type bigIDs struct {
+	ID      int64   `json:"id"`
+	Counter uint64  `json:"counter"`
+	Price   float64 `json:"price"`
+	Refs    []int64 `json:"refs"`
+	Owner   struct {
+		ID int64 `json:"id"`
+	} `json:"owner"`
+}
+
+func TestUseNumberKeepsPrecision(t *testing.T) {
+	owner := map_validator.BuildRoles().SetRule("id", map_validator.Int64())
+	rules := map_validator.BuildRoles().
+		SetRule("id", map_validator.Int64()).
+		SetRule("counter", map_validator.Rules{Type: reflect.Uint64}).
+		SetRule("price", map_validator.Float64()).
+		SetRule("refs", map_validator.List(map_validator.Int64())).
+		SetRule("owner", map_validator.NestedObject(owner)).
+		SetSetting(map_validator.BuildSetting().MakeUseNumber().Done()).
+		Done()
+	body := `{"id": 9007199254740993, "counter": 18446744073709551615, "price": 1.25, "refs": [9007199254740995], "owner": {"id": 9223372036854775807}}`
+	req := httptest.NewRequest("POST", "/test", bytes.NewBufferString(body))
+
+	got, err := map_validator.ValidateJSON[bigIDs](req, rules)
+	if err != nil {
+		t.Fatalf("Expected not have error, but got error : %s", err)
+	}
+	if got.ID != 9007199254740993 || got.Counter != 18446744073709551615 || got.Price != 1.25 {
+		t.Errorf("Expected exact numbers, but got %+v", got)
+	}
+	if len(got.Refs) != 1 || got.Refs[0] != 9007199254740995 || got.Owner.ID != 9223372036854775807 {
+		t.Errorf("Expected exact list and nested numbers, but got %+v", got)
+	}
+}
+
+func TestUseNumberRangePerKind(t *testing.T) {
+	testCases := []struct {
+		name string
+		rule map_validator.Rules
+		body string
+		code string
+	}{
+		{"int8 overflow", map_validator.Rules{Type: reflect.Int8}, `{"v": 128}`, map_validator.CodeRange},
+		{"uint negative", map_validator.Rules{Type: reflect.Uint16}, `{"v": -1}`, map_validator.CodeRange},
+		{"int64 overflow", map_validator.Int64(), `{"v": 9223372036854775808}`, map_validator.CodeRange},
+		{"fraction", map_validator.Int(), `{"v": 1.5}`, map_validator.CodeType},
+		{"string rule", map_validator.Str(), `{"v": 1}`, map_validator.CodeType},
+		{"enum", map_validator.IntEnum(1, 2), `{"v": 3}`, map_validator.CodeEnum},
+		{"min", map_validator.Int64().WithMin(9007199254740993), `{"v": 9007199254740992}`, map_validator.CodeMin},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rules := map_validator.BuildRoles().SetRule("v", tc.rule).Done()
+			req := httptest.NewRequest("POST", "/test", bytes.NewBufferString(tc.body))
+			check, err := map_validator.NewValidateBuilder().UseNumber().SetRules(rules).LoadJsonHttp(req)
+			if err != nil {
+				t.Fatalf("Expected not have error, but got error : %s", err)
+			}
+			_, err = check.RunValidate()
+			var fieldErr *map_validator.FieldError
+			if !errors.As(err, &fieldErr) || fieldErr.Code != tc.code {
+				t.Errorf("Expected %s error, but got %v", tc.code, err)
+			}
+		})
+	}
+}